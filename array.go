@@ -0,0 +1,111 @@
+package vtypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Array is an implementation of TextMarshalUnmarshaler that wraps an array
+// value (possibly with multiple levels of pointers) of any type supported by
+// [Hydrate]. Each UnmarshalText call replaces the array contents: empty chunks
+// are skipped (e.g., "1,2," provides two values), the remaining values are
+// assigned in order, positions without a value are left at their zero value,
+// and providing more values than the array can hold is an error.
+type Array struct {
+	ptrValue any // Stores the original value (e.g., **[3]int, *[3]int)
+
+	Separator string
+}
+
+// MakeArray returns an instance of Array.
+func MakeArray(ptrValue any) Array {
+	return Array{
+		ptrValue:  ptrValue,
+		Separator: ",", // Default separator for comma-separated lists
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (a *Array) UnmarshalText(text []byte) error {
+	return a.unmarshalTextContext(context.Background(), text)
+}
+
+// unmarshalTextContext is like UnmarshalText, but hydrates elements using ctx.
+func (a *Array) unmarshalTextContext(ctx context.Context, text []byte) error {
+	// Preserve nil state if no text
+	if len(text) == 0 {
+		return nil
+	}
+
+	chunks := make([]string, 0, strings.Count(string(text), a.Separator)+1)
+	for _, chunk := range strings.Split(string(text), a.Separator) {
+		if len(chunk) == 0 {
+			continue // Skip empty chunks
+		}
+		chunks = append(chunks, chunk)
+	}
+
+	v := reflect.ValueOf(a.ptrValue)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			// Initialize only if we have values to add
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Array || !v.CanSet() {
+		return errors.New("array: contained value is not a pointer to an array")
+	}
+
+	if len(chunks) > v.Len() {
+		return fmt.Errorf("array: %w: got %d, want at most %d", ErrArrayLength, len(chunks), v.Len())
+	}
+
+	arr := reflect.New(v.Type()).Elem()
+	for i, chunk := range chunks {
+		if err := HydrateContext(ctx, arr.Index(i).Addr().Interface(), chunk); err != nil {
+			return NewPathError(err, "array", fmt.Sprintf("[%d]", i))
+		}
+	}
+	v.Set(arr)
+
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (a *Array) MarshalText() ([]byte, error) {
+	v := reflect.ValueOf(a.ptrValue)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, nil // Return nil text for nil pointers
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Array {
+		return nil, errors.New("array: contained value is not a pointer to an array")
+	}
+
+	out := make([]string, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		out[i] = fmt.Sprint(v.Index(i).Interface())
+	}
+	return []byte(strings.Join(out, a.Separator)), nil
+}
+
+// ValueTypeName returns the name of the underlying array type (e.g., "[3]int").
+func (a *Array) ValueTypeName() string {
+	t := reflect.TypeOf(a.ptrValue)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.String()
+}
+
+// Value returns the original value with its pointer chain.
+func (a *Array) Value() any {
+	return a.ptrValue
+}
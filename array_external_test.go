@@ -0,0 +1,67 @@
+package vtypes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestConvertCompatibleWithPointerArray(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       string
+		want      [3]uint8
+		wantErr   bool
+		wantErrIs error
+	}{
+		{name: "full", raw: "255,128,0", want: [3]uint8{255, 128, 0}},
+		{name: "too few", raw: "1,2", want: [3]uint8{1, 2, 0}},
+		{name: "empty chunk", raw: "1,,3", want: [3]uint8{1, 3, 0}},
+		{name: "trailing separator", raw: "1,2,", want: [3]uint8{1, 2, 0}},
+		{name: "full with trailing separator", raw: "1,2,3,", want: [3]uint8{1, 2, 3}},
+		{name: "only separators", raw: ",,,,", want: [3]uint8{0, 0, 0}},
+		{name: "too many", raw: "1,2,3,4", want: [3]uint8{9, 9, 9}, wantErr: true, wantErrIs: vtypes.ErrArrayLength},
+		{name: "invalid", raw: "1,x", want: [3]uint8{9, 9, 9}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := [3]uint8{9, 9, 9}
+			result := vtypes.ConvertCompatible(&got)
+			arrayVal, ok := result.(*vtypes.Array)
+			if !ok {
+				t.Fatalf("Expected *vtypes.Array, got %T", result)
+			}
+
+			err := vtypes.Hydrate(arrayVal, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("Expected %v in chain, got %v", tt.wantErrIs, err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestArrayText(t *testing.T) {
+	var got *[3]int // nil
+	arrayVal := vtypes.MakeArray(&got)
+
+	if text := vtypes.DefaultValueText(&arrayVal); text != "" {
+		t.Errorf("Expected empty default text, got %q", text)
+	}
+	if err := arrayVal.UnmarshalText([]byte("1,2,3")); err != nil {
+		t.Fatalf("UnmarshalText error: %v", err)
+	}
+	if text := vtypes.DefaultValueText(&arrayVal); text != "1,2,3" {
+		t.Errorf("Expected default text 1,2,3, got %q", text)
+	}
+	if name := vtypes.ValueTypeName(&arrayVal); name != "[3]int" {
+		t.Errorf("Expected type name [3]int, got %q", name)
+	}
+}
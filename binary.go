@@ -0,0 +1,59 @@
+package vtypes
+
+import (
+	"encoding"
+	"fmt"
+)
+
+// Binary is an implementation of TextMarshalUnmarshaler that wraps a value
+// implementing [encoding.BinaryUnmarshaler]. The text is decoded using the
+// selected encoding and the resulting bytes are passed to UnmarshalBinary
+// (e.g., with BytesRaw, the bytes of the text are used as-is, while with
+// BytesBase64, the text must be standard base64). If the wrapped value also
+// implements [encoding.BinaryMarshaler], MarshalText encodes its bytes the
+// same way; otherwise, nil text is returned.
+type Binary struct {
+	ptr encoding.BinaryUnmarshaler
+
+	Encoding BytesEncoding
+}
+
+// MakeBinary returns an instance of Binary.
+func MakeBinary(ptr encoding.BinaryUnmarshaler, enc BytesEncoding) Binary {
+	return Binary{
+		ptr:      ptr,
+		Encoding: enc,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (b *Binary) UnmarshalText(text []byte) error {
+	data, err := decodeBytes(b.Encoding, text)
+	if err != nil {
+		return err
+	}
+	return b.ptr.UnmarshalBinary(data)
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (b *Binary) MarshalText() ([]byte, error) {
+	m, ok := b.ptr.(encoding.BinaryMarshaler)
+	if !ok {
+		return nil, nil
+	}
+
+	data, err := m.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return encodeBytes(b.Encoding, data)
+}
+
+// ValueTypeName returns the name of the wrapped type, adding the encoding when
+// it is not raw (e.g., "binary(base64)").
+func (b *Binary) ValueTypeName() string {
+	if b.Encoding == BytesRaw {
+		return "binary"
+	}
+	return fmt.Sprintf("binary(%v)", b.Encoding)
+}
@@ -0,0 +1,91 @@
+package vtypes_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+// triple implements only the binary encoding interfaces.
+type triple [3]byte
+
+func (t *triple) UnmarshalBinary(data []byte) error {
+	if len(data) != 3 {
+		return fmt.Errorf("want 3 bytes, got %d", len(data))
+	}
+	copy(t[:], data)
+	return nil
+}
+
+func (t *triple) MarshalBinary() ([]byte, error) {
+	return t[:], nil
+}
+
+// unmarshalOnly implements only encoding.BinaryUnmarshaler.
+type unmarshalOnly []byte
+
+func (u *unmarshalOnly) UnmarshalBinary(data []byte) error {
+	*u = append((*u)[:0], data...)
+	return nil
+}
+
+func TestBinary(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding vtypes.BytesEncoding
+		raw      string
+		want     triple
+		wantErr  bool
+	}{
+		{name: "raw", encoding: vtypes.BytesRaw, raw: "abc", want: triple{'a', 'b', 'c'}},
+		{name: "hex", encoding: vtypes.BytesHex, raw: "010203", want: triple{1, 2, 3}},
+		{name: "base64", encoding: vtypes.BytesBase64, raw: "AQID", want: triple{1, 2, 3}},
+		{name: "invalid hex", encoding: vtypes.BytesHex, raw: "zz", wantErr: true},
+		{name: "rejected by type", encoding: vtypes.BytesRaw, raw: "abcd", wantErr: true},
+		{name: "unknown encoding", encoding: vtypes.BytesEncoding(9), raw: "abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got triple
+			bv := vtypes.MakeBinary(&got, tt.encoding)
+
+			err := vtypes.Hydrate(&bv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				var herr *vtypes.HydrateError
+				if !errors.As(err, &herr) {
+					t.Errorf("Expected *vtypes.HydrateError in chain, got %v", err)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+
+			text, err := bv.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText error: %v", err)
+			}
+			if string(text) != tt.raw {
+				t.Errorf("Expected text %q, got %q", tt.raw, text)
+			}
+		})
+	}
+
+	var u unmarshalOnly
+	bv := vtypes.MakeBinary(&u, vtypes.BytesBase64)
+	if err := vtypes.Hydrate(&bv, "aGk="); err != nil || string(u) != "hi" {
+		t.Errorf("Expected hi, got %q (err: %v)", u, err)
+	}
+	if text, err := bv.MarshalText(); text != nil || err != nil {
+		t.Errorf("Expected nil text without a marshaler, got %q (err: %v)", text, err)
+	}
+	if name := vtypes.ValueTypeName(&bv); name != "binary(base64)" {
+		t.Errorf("Expected type name binary(base64), got %q", name)
+	}
+}
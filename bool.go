@@ -0,0 +1,52 @@
+package vtypes
+
+import "strconv"
+
+// Bool is an implementation of OnSetter that wraps a bool value, centralizing
+// the handling of negated flags (e.g., --no-feature). An empty value sets
+// true, a value equal to Negation sets false, and other values are parsed
+// using [strconv.ParseBool]. A framework can therefore hydrate "--feature" as
+// "" and "--no-feature" as Negation.
+type Bool struct {
+	ptr *bool
+
+	Negation string
+}
+
+// MakeBool returns an instance of Bool with a Negation of "no".
+func MakeBool(ptr *bool) Bool {
+	return Bool{
+		ptr:      ptr,
+		Negation: "no",
+	}
+}
+
+// OnSet sets the wrapped value.
+func (b *Bool) OnSet(val string) error {
+	switch {
+	case val == "":
+		*b.ptr = true
+		return nil
+	case b.Negation != "" && val == b.Negation:
+		*b.ptr = false
+		return nil
+	}
+
+	v, err := strconv.ParseBool(val)
+	if err != nil {
+		return err
+	}
+	*b.ptr = v
+	return nil
+}
+
+// IsBool indicates that the value is intended to be set without a value.
+func (b *Bool) IsBool() bool { return true }
+
+// DefaultValueText returns the current value.
+func (b *Bool) DefaultValueText() string {
+	if b.ptr == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b.ptr)
+}
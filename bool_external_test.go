@@ -0,0 +1,60 @@
+package vtypes_test
+
+import (
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestBool(t *testing.T) {
+	tests := []struct {
+		name     string
+		negation *string
+		init     bool
+		raw      string
+		want     bool
+		wantErr  bool
+	}{
+		{name: "empty", raw: "", want: true},
+		{name: "true", raw: "true", want: true},
+		{name: "false", init: true, raw: "false", want: false},
+		{name: "negation", init: true, raw: "no", want: false},
+		{name: "custom negation", negation: ptr("off"), init: true, raw: "off", want: false},
+		{name: "custom negation replaces default", negation: ptr("off"), init: true, raw: "no", want: true, wantErr: true},
+		{name: "no negation", negation: ptr(""), raw: "", want: true},
+		{name: "invalid", init: true, raw: "maybe", want: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.init
+			bv := vtypes.MakeBool(&got)
+			if tt.negation != nil {
+				bv.Negation = *tt.negation
+			}
+
+			err := vtypes.Hydrate(&bv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBoolText(t *testing.T) {
+	var got bool
+	bv := vtypes.MakeBool(&got)
+
+	if !bv.IsBool() {
+		t.Error("Expected IsBool to be true")
+	}
+	if name := vtypes.ValueTypeName(&bv); name != "bool" {
+		t.Errorf("Expected type name bool, got %q", name)
+	}
+	if text := vtypes.DefaultValueText(&bv); text != "false" {
+		t.Errorf("Expected default text false, got %q", text)
+	}
+}
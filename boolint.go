@@ -0,0 +1,46 @@
+package vtypes
+
+import "strconv"
+
+// BoolInt is an implementation of OnSetter that wraps an int value which is
+// set to 1 or 0 using the values accepted by [strconv.ParseBool] (e.g.,
+// "true", "false", "1", or "0"). An empty value sets 1, as when a bool flag is
+// provided without a value.
+type BoolInt struct {
+	ptr *int
+}
+
+// MakeBoolInt returns an instance of BoolInt.
+func MakeBoolInt(ptr *int) BoolInt {
+	return BoolInt{
+		ptr: ptr,
+	}
+}
+
+// OnSet sets the wrapped value.
+func (b *BoolInt) OnSet(val string) error {
+	v := true
+	if val != "" {
+		var err error
+		if v, err = strconv.ParseBool(val); err != nil {
+			return err
+		}
+	}
+
+	*b.ptr = 0
+	if v {
+		*b.ptr = 1
+	}
+	return nil
+}
+
+// IsBool indicates that the value is intended to be set without a value.
+func (b *BoolInt) IsBool() bool { return true }
+
+// DefaultValueText returns the current value.
+func (b *BoolInt) DefaultValueText() string {
+	if b.ptr == nil {
+		return ""
+	}
+	return strconv.Itoa(*b.ptr)
+}
@@ -0,0 +1,49 @@
+package vtypes_test
+
+import (
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestBoolInt(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    int
+		wantErr bool
+	}{
+		{name: "true", raw: "true", want: 1},
+		{name: "false", raw: "false", want: 0},
+		{name: "one", raw: "1", want: 1},
+		{name: "zero", raw: "0", want: 0},
+		{name: "short", raw: "F", want: 0},
+		{name: "empty", raw: "", want: 1},
+		{name: "two", raw: "2", want: 7, wantErr: true},
+		{name: "invalid", raw: "yes", want: 7, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := 7
+			bv := vtypes.MakeBoolInt(&got)
+
+			err := vtypes.Hydrate(&bv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+
+	got := 1
+	bv := vtypes.MakeBoolInt(&got)
+	if name := vtypes.ValueTypeName(&bv); name != "bool" {
+		t.Errorf("Expected type name bool, got %q", name)
+	}
+	if text := vtypes.DefaultValueText(&bv); text != "1" {
+		t.Errorf("Expected default text 1, got %q", text)
+	}
+}
@@ -0,0 +1,104 @@
+package vtypes
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// BytesEncoding identifies the text encoding used by [Bytes].
+type BytesEncoding int
+
+// BytesEncoding values.
+const (
+	BytesRaw BytesEncoding = iota
+	BytesHex
+	BytesBase64
+)
+
+// String implements [fmt.Stringer].
+func (e BytesEncoding) String() string {
+	switch e {
+	case BytesRaw:
+		return "raw"
+	case BytesHex:
+		return "hex"
+	case BytesBase64:
+		return "base64"
+	default:
+		return fmt.Sprintf("BytesEncoding(%d)", int(e))
+	}
+}
+
+// Bytes is an implementation of TextMarshalUnmarshaler that wraps a byte slice
+// value which is decoded from and encoded to text using the selected encoding.
+// Base64 uses [base64.StdEncoding].
+type Bytes struct {
+	ptr *[]byte
+
+	Encoding BytesEncoding
+}
+
+// MakeBytes returns an instance of Bytes.
+func MakeBytes(ptr *[]byte, encoding BytesEncoding) Bytes {
+	return Bytes{
+		ptr:      ptr,
+		Encoding: encoding,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (b *Bytes) UnmarshalText(text []byte) error {
+	v, err := decodeBytes(b.Encoding, text)
+	if err != nil {
+		return err
+	}
+
+	*b.ptr = v
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (b *Bytes) MarshalText() ([]byte, error) {
+	if b.ptr == nil {
+		return nil, nil
+	}
+	return encodeBytes(b.Encoding, *b.ptr)
+}
+
+// ValueTypeName returns the name of the wrapped type, adding the encoding when
+// it is not raw (e.g., "bytes(base64)").
+func (b *Bytes) ValueTypeName() string {
+	if b.Encoding == BytesRaw {
+		return "bytes"
+	}
+	return fmt.Sprintf("bytes(%v)", b.Encoding)
+}
+
+// decodeBytes returns text decoded using enc.
+func decodeBytes(enc BytesEncoding, text []byte) ([]byte, error) {
+	switch enc {
+	case BytesRaw:
+		return append([]byte(nil), text...), nil
+	case BytesHex:
+		return hex.DecodeString(string(text))
+	case BytesBase64:
+		return base64.StdEncoding.DecodeString(string(text))
+	default:
+		return nil, fmt.Errorf("%w: bytes encoding %v", ErrValueUnsupported, enc)
+	}
+}
+
+// encodeBytes returns data encoded using enc.
+func encodeBytes(enc BytesEncoding, data []byte) ([]byte, error) {
+	switch enc {
+	case BytesRaw:
+		return append([]byte(nil), data...), nil
+	case BytesHex:
+		return []byte(hex.EncodeToString(data)), nil
+	case BytesBase64:
+		return []byte(base64.StdEncoding.EncodeToString(data)), nil
+	default:
+		return nil, fmt.Errorf("%w: bytes encoding %v", ErrValueUnsupported, enc)
+	}
+}
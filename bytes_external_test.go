@@ -0,0 +1,62 @@
+package vtypes_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestBytes(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding vtypes.BytesEncoding
+		raw      string
+		want     []byte
+		wantErr  bool
+	}{
+		{name: "raw", encoding: vtypes.BytesRaw, raw: "hi!", want: []byte("hi!")},
+		{name: "hex", encoding: vtypes.BytesHex, raw: "68692a", want: []byte("hi*")},
+		{name: "base64", encoding: vtypes.BytesBase64, raw: "aGkh", want: []byte("hi!")},
+		{name: "invalid hex", encoding: vtypes.BytesHex, raw: "zz", wantErr: true},
+		{name: "invalid base64", encoding: vtypes.BytesBase64, raw: "a#", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []byte
+			bv := vtypes.MakeBytes(&got, tt.encoding)
+
+			err := vtypes.Hydrate(&bv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+			if text := vtypes.DefaultValueText(&bv); text != tt.raw {
+				t.Errorf("Expected default text %q, got %q", tt.raw, text)
+			}
+		})
+	}
+}
+
+func TestBytesBase64Error(t *testing.T) {
+	var got []byte
+	bv := vtypes.MakeBytes(&got, vtypes.BytesBase64)
+
+	err := vtypes.Hydrate(&bv, "a#")
+	var cerr base64.CorruptInputError
+	if !errors.As(err, &cerr) {
+		t.Errorf("Expected base64.CorruptInputError in chain, got %v", err)
+	}
+	var herr *vtypes.HydrateError
+	if !errors.As(err, &herr) {
+		t.Errorf("Expected *vtypes.HydrateError in chain, got %v", err)
+	}
+}
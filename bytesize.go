@@ -0,0 +1,108 @@
+package vtypes
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type byteUnit struct {
+	name string
+	size int64
+}
+
+// byteUnits lists the units supported by ByteSize, largest first within each
+// family.
+var byteUnits = []byteUnit{
+	{"EiB", 1 << 60}, {"PiB", 1 << 50}, {"TiB", 1 << 40}, {"GiB", 1 << 30}, {"MiB", 1 << 20}, {"KiB", 1 << 10},
+	{"EB", 1e18}, {"PB", 1e15}, {"TB", 1e12}, {"GB", 1e9}, {"MB", 1e6}, {"KB", 1e3},
+	{"B", 1},
+}
+
+// ByteSize is an implementation of TextMarshalUnmarshaler that wraps an int64
+// byte count expressed as a number with an optional unit suffix. SI units (KB,
+// MB, GB, TB, PB, EB) are powers of 1000, and IEC units (KiB, MiB, GiB, TiB,
+// PiB, EiB) are powers of 1024. Units are matched case-insensitively, a bare
+// number is treated as bytes, and fractional values (e.g., "1.5MB") are rounded
+// to the nearest byte.
+type ByteSize struct {
+	ptr *int64
+}
+
+// MakeByteSize returns an instance of ByteSize.
+func MakeByteSize(ptr *int64) ByteSize {
+	return ByteSize{
+		ptr: ptr,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (b *ByteSize) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+	i := strings.IndexFunc(s, unicode.IsLetter)
+	if i < 0 {
+		i = len(s)
+	}
+	num, unit := strings.TrimSpace(s[:i]), s[i:]
+
+	size := int64(1)
+	if unit != "" {
+		size = 0
+		for _, u := range byteUnits {
+			if strings.EqualFold(u.name, unit) {
+				size = u.size
+				break
+			}
+		}
+		if size == 0 {
+			return fmt.Errorf("%w: byte size unit %q", ErrValueUnsupported, unit)
+		}
+	}
+
+	if n, err := strconv.ParseInt(num, 10, 64); err == nil {
+		if n > math.MaxInt64/size || n < math.MinInt64/size {
+			return fmt.Errorf("%w: byte size %q overflows int64", ErrOutOfRange, text)
+		}
+		*b.ptr = n * size
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return err
+	}
+	f = math.Round(f * float64(size))
+	if f >= math.MaxInt64 || f < math.MinInt64 {
+		return fmt.Errorf("%w: byte size %q overflows int64", ErrOutOfRange, text)
+	}
+	*b.ptr = int64(f)
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler]. The value is expressed using
+// the largest unit that divides it exactly (e.g., "10MiB" or "1500KB").
+func (b *ByteSize) MarshalText() ([]byte, error) {
+	if b.ptr == nil {
+		return nil, nil
+	}
+	return []byte(formatByteSize(*b.ptr)), nil
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (b *ByteSize) ValueTypeName() string {
+	return "bytesize"
+}
+
+func formatByteSize(n int64) string {
+	best := byteUnits[len(byteUnits)-1]
+	if n != 0 {
+		for _, u := range byteUnits {
+			if n%u.size == 0 && u.size > best.size {
+				best = u
+			}
+		}
+	}
+	return strconv.FormatInt(n/best.size, 10) + best.name
+}
@@ -0,0 +1,80 @@
+package vtypes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestByteSize(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       string
+		want      int64
+		wantErr   bool
+		wantErrIs error
+	}{
+		{name: "bare", raw: "512", want: 512},
+		{name: "bytes", raw: "512B", want: 512},
+		{name: "si", raw: "10MB", want: 10_000_000},
+		{name: "iec", raw: "2GiB", want: 2 << 30},
+		{name: "lower case", raw: "4kib", want: 4096},
+		{name: "spaced", raw: "3 KB", want: 3000},
+		{name: "fractional si", raw: "1.5MB", want: 1_500_000},
+		{name: "fractional iec", raw: "0.5KiB", want: 512},
+		{name: "fractional rounded", raw: "1.5", want: 2},
+		{name: "unknown unit", raw: "10XB", wantErr: true, wantErrIs: vtypes.ErrValueUnsupported},
+		{name: "no number", raw: "MB", wantErr: true},
+		{name: "overflow", raw: "9EiB", wantErr: true, wantErrIs: vtypes.ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got int64
+			bv := vtypes.MakeByteSize(&got)
+
+			err := vtypes.Hydrate(&bv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("Expected %v in chain, got %v", tt.wantErrIs, err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestByteSizeText(t *testing.T) {
+	tests := []struct {
+		val  int64
+		want string
+	}{
+		{val: 0, want: "0B"},
+		{val: 512, want: "512B"},
+		{val: 10 << 20, want: "10MiB"},
+		{val: 10_000_000, want: "10MB"},
+		{val: 1_500_000, want: "1500KB"},
+		{val: 1001, want: "1001B"},
+		{val: 10485760, want: "10MiB"},
+		{val: 3 << 30, want: "3GiB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := tt.val
+			bv := vtypes.MakeByteSize(&got)
+			if text := vtypes.DefaultValueText(&bv); text != tt.want {
+				t.Errorf("Expected default text %q, got %q", tt.want, text)
+			}
+		})
+	}
+
+	bv := vtypes.MakeByteSize(new(int64))
+	if name := vtypes.ValueTypeName(&bv); name != "bytesize" {
+		t.Errorf("Expected type name bytesize, got %q", name)
+	}
+}
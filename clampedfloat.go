@@ -0,0 +1,57 @@
+package vtypes
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// ClampedFloat is an implementation of TextMarshalUnmarshaler that wraps a
+// float64 value which is limited to the inclusive range of Min to Max. Out of
+// range values (including infinities) are clamped to the nearest bound rather
+// than rejected, so errors are only returned if the text cannot be parsed or
+// is NaN, which has no nearest bound.
+type ClampedFloat struct {
+	ptr *float64
+
+	Min float64
+	Max float64
+}
+
+// MakeClampedFloat returns an instance of ClampedFloat.
+func MakeClampedFloat(ptr *float64, min, max float64) ClampedFloat {
+	return ClampedFloat{
+		ptr: ptr,
+		Min: min,
+		Max: max,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (c *ClampedFloat) UnmarshalText(text []byte) error {
+	f, err := strconv.ParseFloat(string(text), 64)
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return err
+	}
+	if math.IsNaN(f) {
+		return fmt.Errorf("%w: NaN cannot be clamped", ErrValueUnsupported)
+	}
+	// On a range error, f is already ±Inf.
+	*c.ptr = math.Max(c.Min, math.Min(c.Max, f))
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (c *ClampedFloat) MarshalText() ([]byte, error) {
+	if c.ptr == nil {
+		return nil, nil
+	}
+	return []byte(strconv.FormatFloat(*c.ptr, 'g', -1, 64)), nil
+}
+
+// ValueTypeName returns the name of the wrapped type along with its range
+// (e.g., "float64(0..1)").
+func (c *ClampedFloat) ValueTypeName() string {
+	return fmt.Sprintf("float64(%g..%g)", c.Min, c.Max)
+}
@@ -0,0 +1,51 @@
+package vtypes_test
+
+import (
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestClampedFloat(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    float64
+		wantErr bool
+	}{
+		{name: "in range", raw: "0.25", want: 0.25},
+		{name: "below", raw: "-0.1", want: 0},
+		{name: "above", raw: "1.5", want: 1},
+		{name: "infinity", raw: "+Inf", want: 1},
+		{name: "overflow", raw: "-1e999", want: 0},
+		{name: "nan", raw: "NaN", want: 0.5, wantErr: true},
+		{name: "invalid", raw: "half", want: 0.5, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := 0.5
+			cv := vtypes.MakeClampedFloat(&got, 0, 1)
+
+			err := vtypes.Hydrate(&cv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClampedFloatText(t *testing.T) {
+	got := 0.5
+	cv := vtypes.MakeClampedFloat(&got, 0, 1)
+
+	if name := vtypes.ValueTypeName(&cv); name != "float64(0..1)" {
+		t.Errorf("Expected type name float64(0..1), got %q", name)
+	}
+	if text := vtypes.DefaultValueText(&cv); text != "0.5" {
+		t.Errorf("Expected default text 0.5, got %q", text)
+	}
+}
@@ -0,0 +1,59 @@
+package vtypes
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ClampedInt is an implementation of TextMarshalUnmarshaler that wraps an int
+// value which is limited to the inclusive range of Min to Max. Unlike
+// [RangeInt], out of range values are clamped to the nearest bound rather than
+// rejected, so errors are only returned if the text cannot be parsed.
+type ClampedInt struct {
+	ptr *int
+
+	Min int
+	Max int
+}
+
+// MakeClampedInt returns an instance of ClampedInt.
+func MakeClampedInt(ptr *int, min, max int) ClampedInt {
+	return ClampedInt{
+		ptr: ptr,
+		Min: min,
+		Max: max,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler]. Values that are out of
+// range for int itself are also clamped.
+func (c *ClampedInt) UnmarshalText(text []byte) error {
+	n, err := strconv.Atoi(string(text))
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return err
+	}
+	// On a range error, n is already the nearest int bound.
+	if n < c.Min {
+		n = c.Min
+	}
+	if n > c.Max {
+		n = c.Max
+	}
+	*c.ptr = n
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (c *ClampedInt) MarshalText() ([]byte, error) {
+	if c.ptr == nil {
+		return nil, nil
+	}
+	return []byte(strconv.Itoa(*c.ptr)), nil
+}
+
+// ValueTypeName returns the name of the wrapped type along with its range
+// (e.g., "int(1..100)").
+func (c *ClampedInt) ValueTypeName() string {
+	return fmt.Sprintf("int(%d..%d)", c.Min, c.Max)
+}
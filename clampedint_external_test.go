@@ -0,0 +1,51 @@
+package vtypes_test
+
+import (
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestClampedInt(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    int
+		wantErr bool
+	}{
+		{name: "in range", raw: "50", want: 50},
+		{name: "min", raw: "1", want: 1},
+		{name: "below", raw: "-5", want: 1},
+		{name: "above", raw: "1000", want: 100},
+		{name: "int overflow", raw: "99999999999999999999999", want: 100},
+		{name: "int underflow", raw: "-99999999999999999999999", want: 1},
+		{name: "invalid", raw: "lots", want: 42, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := 42
+			cv := vtypes.MakeClampedInt(&got, 1, 100)
+
+			err := vtypes.Hydrate(&cv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClampedIntText(t *testing.T) {
+	got := 42
+	cv := vtypes.MakeClampedInt(&got, 1, 100)
+
+	if name := vtypes.ValueTypeName(&cv); name != "int(1..100)" {
+		t.Errorf("Expected type name int(1..100), got %q", name)
+	}
+	if text := vtypes.DefaultValueText(&cv); text != "42" {
+		t.Errorf("Expected default text 42, got %q", text)
+	}
+}
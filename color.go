@@ -0,0 +1,69 @@
+package vtypes
+
+import (
+	"encoding/hex"
+	"fmt"
+	"image/color"
+	"strings"
+)
+
+// Color is an implementation of TextMarshalUnmarshaler that wraps a color.RGBA
+// value expressed as "#rgb", "#rrggbb", or "#rrggbbaa" hex text. Components are
+// stored as given (i.e., no alpha-premultiplication is applied), and a missing
+// alpha component is treated as fully opaque.
+type Color struct {
+	ptr *color.RGBA
+}
+
+// MakeColor returns an instance of Color.
+func MakeColor(ptr *color.RGBA) Color {
+	return Color{
+		ptr: ptr,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (c *Color) UnmarshalText(text []byte) error {
+	s := string(text)
+	if !strings.HasPrefix(s, "#") {
+		return fmt.Errorf("%w: color %q must start with '#'", ErrValueUnsupported, s)
+	}
+	s = s[1:]
+
+	if len(s) == 3 {
+		s = string([]byte{s[0], s[0], s[1], s[1], s[2], s[2]})
+	}
+	if len(s) == 6 {
+		s += "ff"
+	}
+	if len(s) != 8 {
+		return fmt.Errorf("%w: color %q must be #rgb, #rrggbb, or #rrggbbaa", ErrValueUnsupported, text)
+	}
+
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+
+	*c.ptr = color.RGBA{R: b[0], G: b[1], B: b[2], A: b[3]}
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler]. The alpha component is only
+// included when the color is not fully opaque.
+func (c *Color) MarshalText() ([]byte, error) {
+	if c.ptr == nil {
+		return nil, nil
+	}
+
+	v := *c.ptr
+	if v.A == 0xff {
+		return []byte(fmt.Sprintf("#%02x%02x%02x", v.R, v.G, v.B)), nil
+	}
+	return []byte(fmt.Sprintf("#%02x%02x%02x%02x", v.R, v.G, v.B, v.A)), nil
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (c *Color) ValueTypeName() string {
+	return "color"
+}
@@ -0,0 +1,54 @@
+package vtypes_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestColor(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		want     color.RGBA
+		wantText string
+		wantErr  bool
+	}{
+		{name: "short", raw: "#f0a", want: color.RGBA{0xff, 0x00, 0xaa, 0xff}, wantText: "#ff00aa"},
+		{name: "long", raw: "#1A2b3C", want: color.RGBA{0x1a, 0x2b, 0x3c, 0xff}, wantText: "#1a2b3c"},
+		{name: "alpha", raw: "#11223380", want: color.RGBA{0x11, 0x22, 0x33, 0x80}, wantText: "#11223380"},
+		{name: "opaque alpha", raw: "#112233ff", want: color.RGBA{0x11, 0x22, 0x33, 0xff}, wantText: "#112233"},
+		{name: "missing hash", raw: "112233", wantErr: true},
+		{name: "bad length", raw: "#1122", wantErr: true},
+		{name: "bad hex", raw: "#gg2233", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got color.RGBA
+			cv := vtypes.MakeColor(&got)
+
+			err := vtypes.Hydrate(&cv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+			if text := vtypes.DefaultValueText(&cv); text != tt.wantText {
+				t.Errorf("Expected default text %q, got %q", tt.wantText, text)
+			}
+		})
+	}
+}
+
+func TestColorTypeName(t *testing.T) {
+	cv := vtypes.MakeColor(new(color.RGBA))
+	if name := vtypes.ValueTypeName(&cv); name != "color" {
+		t.Errorf("Expected type name color, got %q", name)
+	}
+}
@@ -0,0 +1,54 @@
+package vtypes
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Counter is an implementation of OnSetter that wraps an int value which is
+// incremented each time it is hydrated, as is common for repeated verbosity
+// flags (e.g., -v -v -v).
+type Counter struct {
+	ptr *int
+}
+
+// MakeCounter returns an instance of Counter.
+func MakeCounter(ptr *int) Counter {
+	return Counter{
+		ptr: ptr,
+	}
+}
+
+// OnSet increments the wrapped value. An empty value adds 1, an integer value
+// adds that amount, and a bool value adds 1 if true and 0 if false.
+func (c *Counter) OnSet(val string) error {
+	if val == "" {
+		*c.ptr++
+		return nil
+	}
+
+	if n, err := strconv.Atoi(val); err == nil {
+		*c.ptr += n
+		return nil
+	}
+
+	b, err := strconv.ParseBool(val)
+	if err != nil {
+		return fmt.Errorf("%w: counter %q", ErrValueUnsupported, val)
+	}
+	if b {
+		*c.ptr++
+	}
+	return nil
+}
+
+// IsBool indicates that the counter is intended to be set without a value.
+func (c *Counter) IsBool() bool { return true }
+
+// DefaultValueText returns the current count.
+func (c *Counter) DefaultValueText() string {
+	if c.ptr == nil {
+		return ""
+	}
+	return strconv.Itoa(*c.ptr)
+}
@@ -0,0 +1,34 @@
+package vtypes_test
+
+import (
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestCounter(t *testing.T) {
+	var got int
+	cv := vtypes.MakeCounter(&got)
+
+	for _, raw := range []string{"", "true", "", "false", "3"} {
+		if err := vtypes.Hydrate(&cv, raw); err != nil {
+			t.Fatalf("Hydrate(%q) error: %v", raw, err)
+		}
+	}
+	if got != 6 {
+		t.Errorf("Expected 6, got %d", got)
+	}
+	if text := vtypes.DefaultValueText(&cv); text != "6" {
+		t.Errorf("Expected default text 6, got %q", text)
+	}
+	if !cv.IsBool() {
+		t.Errorf("Expected IsBool to be true")
+	}
+
+	if err := vtypes.Hydrate(&cv, "lots"); err == nil {
+		t.Errorf("Expected error for invalid value, got nil")
+	}
+	if got != 6 {
+		t.Errorf("Expected 6 after invalid value, got %d", got)
+	}
+}
@@ -0,0 +1,168 @@
+package vtypes
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Dehydrate is the inverse of [Hydrate], returning the text that would
+// reproduce the value of val. val may be a value or a pointer (possibly with
+// multiple levels) to a value of a type that Hydrate supports, except that
+// types with a handler set using [Register] are unsupported. Floats are
+// formatted using the fewest digits needed to round-trip, times use
+// [time.RFC3339Nano], and [TextMarshalUnmarshaler], [fmt.Stringer], and
+// [database/sql/driver.Valuer] types are formatted using MarshalText, String,
+// and Value respectively. Types which are only supported by Hydrate as a
+// [Setter], [ContextSetter], or [OnSetter] provide no text, and so are
+// unsupported.
+func Dehydrate(val any) (string, error) {
+	s, err := dehydrate(val)
+	if err != nil {
+		return "", NewError(fmt.Errorf("dehydrate (type: %T): %w", val, err))
+	}
+	return s, nil
+}
+
+func dehydrate(val any) (string, error) {
+	v := reflect.ValueOf(val)
+	if !v.IsValid() {
+		return "", ErrNilValue
+	}
+
+	if v.Kind() != reflect.Pointer {
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		v = p
+	}
+	for v.Elem().Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", ErrNilValue
+		}
+		v = v.Elem()
+	}
+	if v.IsNil() {
+		return "", ErrNilValue
+	}
+
+	return dehydrateValue(v.Interface())
+}
+
+// dehydrateValue mirrors hydrateValue for the single-pointer value val.
+func dehydrateValue(val any) (string, error) {
+	switch v := val.(type) {
+	case *string:
+		return *v, nil
+
+	case *[]byte:
+		return string(*v), nil
+
+	case *bool:
+		return strconv.FormatBool(*v), nil
+
+	case *int:
+		return strconv.Itoa(*v), nil
+
+	case *int64:
+		return strconv.FormatInt(*v, 10), nil
+
+	case *int8:
+		return strconv.FormatInt(int64(*v), 10), nil
+
+	case *int16:
+		return strconv.FormatInt(int64(*v), 10), nil
+
+	case *int32:
+		return strconv.FormatInt(int64(*v), 10), nil
+
+	case *uint:
+		return strconv.FormatUint(uint64(*v), 10), nil
+
+	case *uint64:
+		return strconv.FormatUint(*v, 10), nil
+
+	case *uint8:
+		return strconv.FormatUint(uint64(*v), 10), nil
+
+	case *uint16:
+		return strconv.FormatUint(uint64(*v), 10), nil
+
+	case *uint32:
+		return strconv.FormatUint(uint64(*v), 10), nil
+
+	case *float64:
+		return strconv.FormatFloat(*v, 'g', -1, 64), nil
+
+	case *float32:
+		return strconv.FormatFloat(float64(*v), 'g', -1, 32), nil
+
+	case *complex128:
+		return strconv.FormatComplex(*v, 'g', -1, 128), nil
+
+	case *complex64:
+		return strconv.FormatComplex(complex128(*v), 'g', -1, 64), nil
+
+	case *time.Duration:
+		return v.String(), nil
+
+	case *time.Time:
+		return v.Format(time.RFC3339Nano), nil
+
+	case *net.IP:
+		if *v == nil {
+			return "", nil
+		}
+		return v.String(), nil
+
+	case *net.IPNet:
+		return v.String(), nil
+
+	case *url.URL:
+		return v.String(), nil
+
+	case *big.Int:
+		return v.String(), nil
+
+	case *big.Float:
+		return v.Text('g', -1), nil
+
+	case TextMarshalUnmarshaler:
+		t, err := v.MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(t), nil
+
+	case fmt.Stringer:
+		return v.String(), nil
+
+	case valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return "", err
+		}
+		if dv == nil {
+			return "", nil
+		}
+		return fmt.Sprint(dv), nil
+
+	case Setter, ContextSetter, OnSetter:
+		return "", fmt.Errorf("%w: %T can be set but provides no text", ErrTypeUnsupported, val)
+
+	default:
+		if reflect.TypeOf(val).Elem().Kind() == reflect.Map {
+			m := MakeMap(val)
+			t, err := m.MarshalText()
+			if err != nil {
+				return "", err
+			}
+			return string(t), nil
+		}
+
+		return "", ErrTypeUnsupported
+	}
+}
@@ -0,0 +1,152 @@
+package vtypes_test
+
+import (
+	"database/sql/driver"
+	"errors"
+	"math"
+	"math/big"
+	"net"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/daved/vtypes"
+)
+
+type cents int64
+
+func (c cents) Value() (driver.Value, error) { return int64(c), nil }
+
+var errBadValue = errors.New("bad value")
+
+type nullable struct{ err error }
+
+func (n nullable) Value() (driver.Value, error) { return nil, n.err }
+
+func TestDehydrate(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 600, time.UTC)
+	bigF := new(big.Float).SetPrec(100)
+	bigF.SetString("1.00000000000000000001")
+
+	tests := []struct {
+		name string
+		val  any
+		want string
+	}{
+		{name: "string", val: ptr("a b"), want: "a b"},
+		{name: "string value", val: "a b", want: "a b"},
+		{name: "bytes", val: &[]byte{'x', 'y'}, want: "xy"},
+		{name: "bool", val: ptr(true), want: "true"},
+		{name: "int", val: ptr(-42), want: "-42"},
+		{name: "int value", val: 7, want: "7"},
+		{name: "int8", val: ptr(int8(-128)), want: "-128"},
+		{name: "int16", val: ptr(int16(300)), want: "300"},
+		{name: "int32", val: ptr(int32(70000)), want: "70000"},
+		{name: "int64", val: ptr(int64(math.MaxInt64)), want: "9223372036854775807"},
+		{name: "uint", val: ptr(uint(1)), want: "1"},
+		{name: "uint8", val: ptr(uint8(255)), want: "255"},
+		{name: "uint16", val: ptr(uint16(65535)), want: "65535"},
+		{name: "uint32", val: ptr(uint32(1)), want: "1"},
+		{name: "uint64", val: ptr(uint64(math.MaxUint64)), want: "18446744073709551615"},
+		{name: "float64", val: ptr(0.1), want: "0.1"},
+		{name: "float32", val: ptr(float32(0.1)), want: "0.1"},
+		{name: "complex128", val: ptr(complex(1, -2)), want: "(1-2i)"},
+		{name: "complex64", val: ptr(complex64(complex(0.5, 1))), want: "(0.5+1i)"},
+		{name: "duration", val: ptr(90 * time.Second), want: "1m30s"},
+		{name: "time", val: &ts, want: "2024-01-02T03:04:05.0000006Z"},
+		{name: "ip", val: ptr(net.ParseIP("10.0.0.1")), want: "10.0.0.1"},
+		{name: "nil ip", val: new(net.IP), want: ""},
+		{name: "cidr", val: mustCIDR("10.0.0.0/8"), want: "10.0.0.0/8"},
+		{name: "url", val: &url.URL{Scheme: "https", Host: "example.com", Path: "/a"}, want: "https://example.com/a"},
+		{name: "big int", val: mustBigInt("123456789012345678901234567890"), want: "123456789012345678901234567890"},
+		{name: "big float", val: bigF, want: "1.00000000000000000001"},
+		{name: "double pointer", val: ptr(ptr(5)), want: "5"},
+		{name: "map", val: &map[string]int{"b": 2, "a": 1}, want: "a=1,b=2"},
+		{name: "stringer", val: ptr(level("warn")), want: "warn"},
+		{name: "valuer", val: ptr(cents(250)), want: "250"},
+		{name: "nil valuer", val: ptr(nullable{}), want: ""},
+		{name: "text marshaler", val: func() any {
+			s := vtypes.MakeSlice(&[]int{1, 2})
+			return &s
+		}(), want: "1,2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := vtypes.Dehydrate(tt.val)
+			if err != nil {
+				t.Fatalf("Dehydrate error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDehydrateRoundTrip(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 600, time.FixedZone("X", -3600))
+	vals := []any{
+		ptr("x"), ptr(true), ptr(-3), ptr(int8(-8)), ptr(uint16(16)),
+		ptr(math.Pi), ptr(float32(math.E)), ptr(complex(1.5, -0.25)),
+		ptr(1500 * time.Millisecond), &ts, ptr(net.ParseIP("::1")),
+		ptr(mustCIDR("192.168.0.0/16")), mustBigInt("-99999999999999999999"),
+		&map[string]bool{"a": true, "b": false},
+	}
+
+	for _, val := range vals {
+		t.Run(reflect.TypeOf(val).String(), func(t *testing.T) {
+			text, err := vtypes.Dehydrate(val)
+			if err != nil {
+				t.Fatalf("Dehydrate error: %v", err)
+			}
+
+			got := reflect.New(reflect.TypeOf(val).Elem())
+			if err := vtypes.Hydrate(got.Interface(), text); err != nil {
+				t.Fatalf("Hydrate error for %q: %v", text, err)
+			}
+
+			want := reflect.ValueOf(val).Elem().Interface()
+			if wt, ok := want.(time.Time); ok {
+				if !wt.Equal(got.Elem().Interface().(time.Time)) {
+					t.Errorf("Expected %v, got %v", want, got.Elem())
+				}
+				return
+			}
+			if !reflect.DeepEqual(got.Elem().Interface(), want) {
+				t.Errorf("Expected %v after round trip of %q, got %v", want, text, got.Elem())
+			}
+		})
+	}
+}
+
+func TestDehydrateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		val  any
+		want error
+	}{
+		{name: "nil", val: nil, want: vtypes.ErrNilValue},
+		{name: "nil pointer", val: (*int)(nil), want: vtypes.ErrNilValue},
+		{name: "nil inner pointer", val: new(*int), want: vtypes.ErrNilValue},
+		{name: "unsupported", val: new(chan int), want: vtypes.ErrTypeUnsupported},
+		{name: "slice", val: &[]int{1}, want: vtypes.ErrTypeUnsupported},
+		{name: "setter", val: &tags{}, want: vtypes.ErrTypeUnsupported},
+		{name: "context setter", val: &lookup{}, want: vtypes.ErrTypeUnsupported},
+		{name: "valuer error", val: ptr(nullable{err: errBadValue}), want: errBadValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := vtypes.Dehydrate(tt.val)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v in chain, got %v", tt.want, err)
+			}
+			var verr *vtypes.Error
+			if !errors.As(err, &verr) {
+				t.Errorf("Expected *vtypes.Error in chain, got %v", err)
+			}
+		})
+	}
+}
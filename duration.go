@@ -0,0 +1,98 @@
+package vtypes
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration is an implementation of TextMarshalUnmarshaler that wraps a
+// time.Duration value which is parsed using [time.ParseDuration], and which
+// provides help-friendly default text. If DisplayUnit is one of the units
+// accepted by time.ParseDuration (e.g., time.Minute), DefaultValueText renders
+// the value in that unit (e.g., "1.5m"). Otherwise, zero components are
+// dropped (e.g., "1h" rather than "1h0m0s"). Either form can be parsed.
+type Duration struct {
+	ptr *time.Duration
+
+	DisplayUnit time.Duration
+}
+
+// MakeDuration returns an instance of Duration.
+func MakeDuration(ptr *time.Duration, displayUnit time.Duration) Duration {
+	return Duration{
+		ptr:         ptr,
+		DisplayUnit: displayUnit,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (d *Duration) UnmarshalText(text []byte) error {
+	v, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d.ptr = v
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (d *Duration) MarshalText() ([]byte, error) {
+	if d.ptr == nil {
+		return nil, nil
+	}
+	return []byte(d.ptr.String()), nil
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (d *Duration) ValueTypeName() string {
+	return "duration"
+}
+
+// DefaultValueText returns the wrapped value formatted as configured by
+// DisplayUnit.
+func (d *Duration) DefaultValueText() string {
+	if d.ptr == nil {
+		return ""
+	}
+	if suffix, ok := durationUnits[d.DisplayUnit]; ok {
+		return strconv.FormatFloat(float64(*d.ptr)/float64(d.DisplayUnit), 'f', -1, 64) + suffix
+	}
+	return compactDuration(*d.ptr)
+}
+
+var durationUnits = map[time.Duration]string{
+	time.Nanosecond:  "ns",
+	time.Microsecond: "us",
+	time.Millisecond: "ms",
+	time.Second:      "s",
+	time.Minute:      "m",
+	time.Hour:        "h",
+}
+
+// compactDuration formats d like [time.Duration.String] without zero hour,
+// minute, or second components (e.g., "1h", "2m30s", or "1h500ms").
+func compactDuration(d time.Duration) string {
+	if d > -time.Second && d < time.Second {
+		return d.String()
+	}
+
+	var b strings.Builder
+	u := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		u = -u
+	}
+
+	if h := u / uint64(time.Hour); h > 0 {
+		b.WriteString(strconv.FormatUint(h, 10) + "h")
+	}
+	if m := u / uint64(time.Minute) % 60; m > 0 {
+		b.WriteString(strconv.FormatUint(m, 10) + "m")
+	}
+	if rest := u % uint64(time.Minute); rest > 0 {
+		b.WriteString(time.Duration(rest).String())
+	}
+
+	return b.String()
+}
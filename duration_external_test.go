@@ -0,0 +1,70 @@
+package vtypes_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/daved/vtypes"
+)
+
+func TestDuration(t *testing.T) {
+	var got time.Duration
+	dv := vtypes.MakeDuration(&got, 0)
+
+	if err := vtypes.Hydrate(&dv, "1h30m"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if got != 90*time.Minute {
+		t.Errorf("Expected 1h30m0s, got %v", got)
+	}
+	if err := vtypes.Hydrate(&dv, "1d"); err == nil {
+		t.Error("Expected error for unknown unit, got nil")
+	}
+	if text, _ := dv.MarshalText(); string(text) != "1h30m0s" {
+		t.Errorf("Expected text 1h30m0s, got %q", text)
+	}
+	if name := vtypes.ValueTypeName(&dv); name != "duration" {
+		t.Errorf("Expected type name duration, got %q", name)
+	}
+}
+
+func TestDurationDefaultValueText(t *testing.T) {
+	tests := []struct {
+		val  time.Duration
+		unit time.Duration
+		want string
+	}{
+		{val: 0, want: "0s"},
+		{val: time.Hour, want: "1h"},
+		{val: 150 * time.Second, want: "2m30s"},
+		{val: time.Hour + 5*time.Second, want: "1h5s"},
+		{val: time.Hour + 500*time.Millisecond, want: "1h500ms"},
+		{val: 1500 * time.Microsecond, want: "1.5ms"},
+		{val: -90 * time.Minute, want: "-1h30m"},
+		{val: math.MinInt64, want: "-2562047h47m16.854775808s"},
+		{val: 90 * time.Second, unit: time.Minute, want: "1.5m"},
+		{val: 2 * time.Hour, unit: time.Second, want: "7200s"},
+		{val: 250 * time.Millisecond, unit: time.Millisecond, want: "250ms"},
+		{val: time.Hour, unit: 2 * time.Hour, want: "1h"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := tt.val
+			dv := vtypes.MakeDuration(&got, tt.unit)
+
+			text := vtypes.DefaultValueText(&dv)
+			if text != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, text)
+			}
+
+			if tt.val == math.MinInt64 {
+				return // the magnitude cannot be represented when parsing
+			}
+			if err := dv.UnmarshalText([]byte(text)); err != nil || got != tt.val {
+				t.Errorf("Expected round trip to %v, got %v (err: %v)", tt.val, got, err)
+			}
+		})
+	}
+}
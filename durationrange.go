@@ -0,0 +1,73 @@
+package vtypes
+
+import (
+	"fmt"
+	"time"
+)
+
+// DurationRange is an implementation of TextMarshalUnmarshaler that wraps a
+// time.Duration value which must be within the inclusive range of Min to Max.
+// A zero Min or Max leaves that side of the range unbounded.
+type DurationRange struct {
+	ptr *time.Duration
+
+	Min time.Duration
+	Max time.Duration
+}
+
+// MakeDurationRange returns an instance of DurationRange.
+func MakeDurationRange(ptr *time.Duration, min, max time.Duration) DurationRange {
+	return DurationRange{
+		ptr: ptr,
+		Min: min,
+		Max: max,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler]. The wrapped value is
+// left unchanged if the parsed value is out of range.
+func (r *DurationRange) UnmarshalText(text []byte) error {
+	d, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	if (r.Min != 0 && d < r.Min) || (r.Max != 0 && d > r.Max) {
+		return fmt.Errorf("%w: %v not in %s", ErrOutOfRange, d, r.bounds())
+	}
+	*r.ptr = d
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (r *DurationRange) MarshalText() ([]byte, error) {
+	if r.ptr == nil {
+		return nil, nil
+	}
+	return []byte(r.ptr.String()), nil
+}
+
+// ValueTypeName returns the name of the wrapped type along with its range
+// (e.g., "duration(1s..5m0s)"). Unbounded sides are left empty.
+func (r *DurationRange) ValueTypeName() string {
+	return fmt.Sprintf("duration(%s)", r.bounds())
+}
+
+// DefaultValueText returns the wrapped value with zero components dropped
+// (e.g., "1h30m" rather than "1h30m0s").
+func (r *DurationRange) DefaultValueText() string {
+	if r.ptr == nil {
+		return ""
+	}
+	return compactDuration(*r.ptr)
+}
+
+func (r *DurationRange) bounds() string {
+	var min, max string
+	if r.Min != 0 {
+		min = r.Min.String()
+	}
+	if r.Max != 0 {
+		max = r.Max.String()
+	}
+	return min + ".." + max
+}
@@ -0,0 +1,79 @@
+package vtypes_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daved/vtypes"
+)
+
+func TestDurationRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		min, max  time.Duration
+		raw       string
+		want      time.Duration
+		wantErr   bool
+		wantErrIs error
+	}{
+		{name: "min", min: time.Second, max: 5 * time.Minute, raw: "1s", want: time.Second},
+		{name: "max", min: time.Second, max: 5 * time.Minute, raw: "5m", want: 5 * time.Minute},
+		{name: "below", min: time.Second, max: 5 * time.Minute, raw: "999ms", want: time.Minute, wantErr: true, wantErrIs: vtypes.ErrOutOfRange},
+		{name: "above", min: time.Second, max: 5 * time.Minute, raw: "5m1s", want: time.Minute, wantErr: true, wantErrIs: vtypes.ErrOutOfRange},
+		{name: "invalid", min: time.Second, max: 5 * time.Minute, raw: "soon", want: time.Minute, wantErr: true},
+		{name: "unbounded min", max: 5 * time.Minute, raw: "-1h", want: -time.Hour},
+		{name: "unbounded max", min: time.Second, raw: "100h", want: 100 * time.Hour},
+		{name: "unbounded max below", min: time.Second, raw: "1ms", want: time.Minute, wantErr: true, wantErrIs: vtypes.ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := time.Minute
+			rv := vtypes.MakeDurationRange(&got, tt.min, tt.max)
+
+			err := vtypes.Hydrate(&rv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("Expected %v in chain, got %v", tt.wantErrIs, err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDurationRangeText(t *testing.T) {
+	got := 30 * time.Second
+
+	tests := []struct {
+		name     string
+		min, max time.Duration
+		want     string
+	}{
+		{name: "bounded", min: time.Second, max: 5 * time.Minute, want: "duration(1s..5m0s)"},
+		{name: "unbounded min", max: 5 * time.Minute, want: "duration(..5m0s)"},
+		{name: "unbounded max", min: time.Second, want: "duration(1s..)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rv := vtypes.MakeDurationRange(&got, tt.min, tt.max)
+			if name := vtypes.ValueTypeName(&rv); name != tt.want {
+				t.Errorf("Expected type name %s, got %q", tt.want, name)
+			}
+			if text := vtypes.DefaultValueText(&rv); text != "30s" {
+				t.Errorf("Expected default text 30s, got %q", text)
+			}
+		})
+	}
+
+	long := 90 * time.Minute
+	rv := vtypes.MakeDurationRange(&long, 0, 0)
+	if text := vtypes.DefaultValueText(&rv); text != "1h30m" {
+		t.Errorf("Expected default text 1h30m, got %q", text)
+	}
+}
@@ -0,0 +1,50 @@
+package vtypes
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Enum is an implementation of StringSetter that wraps a string value which
+// must be one of a fixed set of choices. If CaseInsensitive is set, the raw
+// value is matched without regard to case and the canonical choice is stored.
+type Enum struct {
+	ptr *string
+
+	Choices         []string
+	CaseInsensitive bool
+}
+
+// MakeEnum returns an instance of Enum.
+func MakeEnum(ptr *string, choices []string, caseInsensitive bool) Enum {
+	return Enum{
+		ptr:             ptr,
+		Choices:         choices,
+		CaseInsensitive: caseInsensitive,
+	}
+}
+
+// Set implements [StringSetter].
+func (e *Enum) Set(val string) error {
+	for _, c := range e.Choices {
+		if c == val || (e.CaseInsensitive && strings.EqualFold(c, val)) {
+			*e.ptr = c
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q (valid: %s)", ErrValueUnsupported, val, strings.Join(e.Choices, "|"))
+}
+
+// String implements [fmt.Stringer].
+func (e *Enum) String() string {
+	if e.ptr == nil {
+		return ""
+	}
+	return *e.ptr
+}
+
+// ValueTypeName returns the name of the wrapped type along with its choices
+// (e.g., "enum(foo|bar|baz)").
+func (e *Enum) ValueTypeName() string {
+	return fmt.Sprintf("enum(%s)", strings.Join(e.Choices, "|"))
+}
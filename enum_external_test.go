@@ -0,0 +1,56 @@
+package vtypes_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestEnum(t *testing.T) {
+	choices := []string{"foo", "Bar", "baz"}
+
+	tests := []struct {
+		name            string
+		raw             string
+		caseInsensitive bool
+		want            string
+		wantErr         bool
+	}{
+		{name: "exact", raw: "foo", want: "foo"},
+		{name: "exact mixed case", raw: "Bar", want: "Bar"},
+		{name: "wrong case sensitive", raw: "bar", want: "baz", wantErr: true},
+		{name: "wrong case insensitive", raw: "BAR", caseInsensitive: true, want: "Bar"},
+		{name: "unknown", raw: "qux", caseInsensitive: true, want: "baz", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := "baz"
+			ev := vtypes.MakeEnum(&got, choices, tt.caseInsensitive)
+
+			err := vtypes.Hydrate(&ev, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), "foo|Bar|baz") {
+				t.Errorf("Expected error to list choices, got %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestEnumText(t *testing.T) {
+	got := "foo"
+	ev := vtypes.MakeEnum(&got, []string{"foo", "bar"}, false)
+
+	if name := vtypes.ValueTypeName(&ev); name != "enum(foo|bar)" {
+		t.Errorf("Expected type name enum(foo|bar), got %q", name)
+	}
+	if text := vtypes.DefaultValueText(&ev); text != "foo" {
+		t.Errorf("Expected default text foo, got %q", text)
+	}
+}
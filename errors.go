@@ -1,6 +1,7 @@
 package vtypes
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -22,32 +23,124 @@ func (e *Error) Unwrap() error {
 	return e.child
 }
 
+// Is reports whether err is an *Error wrapping the same child error, so that
+// [errors.Is] does not match every *Error.
 func (e *Error) Is(err error) bool {
-	return reflect.TypeOf(e) == reflect.TypeOf(err)
+	t, ok := err.(*Error)
+	return ok && sameError(e.child, t.child)
 }
 
 type HydrateError struct {
 	child error
 	Val   any
+	Raw   string
 }
 
 func NewHydrateError(child error, val any) *HydrateError {
-	return &HydrateError{child, val}
+	return &HydrateError{child: child, Val: val}
 }
 
 func (e *HydrateError) Error() string {
-	return fmt.Sprintf("hydrate (type: %T): %v", e.Val, e.child)
+	return fmt.Sprintf("hydrate (type: %T, raw: %q): %v", e.Val, e.Raw, e.child)
 }
 
 func (e *HydrateError) Unwrap() error {
 	return e.child
 }
 
+// MarshalJSON implements [json.Marshaler], so that failures can be consumed
+// programmatically (e.g., `{"type":"*int","raw":"abc","message":"..."}`). The
+// message is the text of the wrapped error.
+func (e *HydrateError) MarshalJSON() ([]byte, error) {
+	var msg string
+	if e.child != nil {
+		msg = e.child.Error()
+	}
+	return json.Marshal(struct {
+		Type    string `json:"type"`
+		Raw     string `json:"raw"`
+		Message string `json:"message"`
+	}{
+		Type:    fmt.Sprintf("%T", e.Val),
+		Raw:     e.Raw,
+		Message: msg,
+	})
+}
+
+// Is reports whether err is a *HydrateError wrapping the same child error. Val
+// and Raw are not compared, so failures for differing values or input match
+// when their cause is the same.
 func (e *HydrateError) Is(err error) bool {
-	return reflect.TypeOf(e) == reflect.TypeOf(err)
+	t, ok := err.(*HydrateError)
+	return ok && sameError(e.child, t.child)
+}
+
+// sameError reports whether a and b are the same non-nil error value.
+func sameError(a, b error) bool {
+	return a != nil && reflect.TypeOf(a).Comparable() && a == b
+}
+
+// PathError records the location of an element within a container (e.g.,
+// Kind "slice" and Elem "[2]") that failed to be hydrated.
+type PathError struct {
+	child error
+	Kind  string
+	Elem  string
+}
+
+func NewPathError(child error, kind, elem string) *PathError {
+	return &PathError{child: child, Kind: kind, Elem: elem}
+}
+
+func (e *PathError) Error() string {
+	return fmt.Sprintf("%s%s: %v", e.Kind, e.Elem, e.child)
+}
+
+func (e *PathError) Unwrap() error {
+	return e.child
+}
+
+// FieldError records the name of a field that failed to be hydrated by
+// [HydrateAll].
+type FieldError struct {
+	child error
+	Name  string
+}
+
+func NewFieldError(child error, name string) *FieldError {
+	return &FieldError{child: child, Name: name}
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Name, e.child)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.child
+}
+
+// ErrorPath returns the combined Elem values of all PathErrors in the chain of
+// err (e.g., `["key"][2]`), outermost first.
+func ErrorPath(err error) string {
+	var path string
+	for ; err != nil; err = errors.Unwrap(err) {
+		if perr, ok := err.(*PathError); ok {
+			path += perr.Elem
+		}
+	}
+	return path
 }
 
 var (
 	ErrTypeUnsupported  = errors.New("type unsupported")
 	ErrValueUnsupported = errors.New("value unsupported")
+	ErrSliceLength      = errors.New("slice length out of range")
+	ErrArrayLength      = errors.New("array length exceeded")
+	ErrOutOfRange       = errors.New("value out of range")
+	ErrInvalidConfig    = errors.New("invalid configuration")
+
+	// ErrNotPointer and ErrNilValue distinguish values that cannot be hydrated
+	// from pointers to unsupported types. Both wrap ErrTypeUnsupported.
+	ErrNotPointer = fmt.Errorf("%w: not a pointer", ErrTypeUnsupported)
+	ErrNilValue   = fmt.Errorf("%w: nil value", ErrTypeUnsupported)
 )
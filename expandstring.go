@@ -0,0 +1,61 @@
+package vtypes
+
+import (
+	"fmt"
+	"os"
+)
+
+// ExpandString is an implementation of StringSetter that wraps a string value,
+// expanding ${var} or $var references (as [os.Expand] does) before assignment.
+// Unknown variables expand to an empty string unless StrictExpand is set, in
+// which case an error is returned.
+type ExpandString struct {
+	ptr    *string
+	lookup func(string) (string, bool)
+
+	StrictExpand bool
+}
+
+// MakeExpandString returns an instance of ExpandString. If lookup is nil,
+// [os.LookupEnv] is used.
+func MakeExpandString(ptr *string, lookup func(string) (string, bool)) ExpandString {
+	if lookup == nil {
+		lookup = os.LookupEnv
+	}
+	return ExpandString{
+		ptr:    ptr,
+		lookup: lookup,
+	}
+}
+
+// Set implements [StringSetter].
+func (e *ExpandString) Set(val string) error {
+	var missing []string
+	expanded := os.Expand(val, func(name string) string {
+		v, ok := e.lookup(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return v
+	})
+
+	if e.StrictExpand && len(missing) > 0 {
+		return fmt.Errorf("%w: undefined variables %q", ErrValueUnsupported, missing)
+	}
+
+	*e.ptr = expanded
+	return nil
+}
+
+// String implements [fmt.Stringer].
+func (e *ExpandString) String() string {
+	if e.ptr == nil {
+		return ""
+	}
+	return *e.ptr
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (e *ExpandString) ValueTypeName() string {
+	return "string"
+}
@@ -0,0 +1,62 @@
+package vtypes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestExpandString(t *testing.T) {
+	lookup := func(name string) (string, bool) {
+		vals := map[string]string{"HOST": "localhost", "PORT": "8080"}
+		v, ok := vals[name]
+		return v, ok
+	}
+
+	tests := []struct {
+		name    string
+		raw     string
+		strict  bool
+		want    string
+		wantErr bool
+	}{
+		{name: "braced", raw: "${HOST}:${PORT}", want: "localhost:8080"},
+		{name: "bare", raw: "$HOST/x", want: "localhost/x"},
+		{name: "no vars", raw: "plain", want: "plain"},
+		{name: "unknown lenient", raw: "${HOST}${NOPE}", want: "localhost"},
+		{name: "unknown strict", raw: "${HOST}${NOPE}", strict: true, want: "orig", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := "orig"
+			ev := vtypes.MakeExpandString(&got, lookup)
+			ev.StrictExpand = tt.strict
+
+			err := vtypes.Hydrate(&ev, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, vtypes.ErrValueUnsupported) {
+				t.Errorf("Expected ErrValueUnsupported in chain, got %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestExpandStringEnv(t *testing.T) {
+	t.Setenv("VTYPES_TEST_EXPAND", "value")
+
+	var got string
+	ev := vtypes.MakeExpandString(&got, nil)
+	if err := vtypes.Hydrate(&ev, "x-${VTYPES_TEST_EXPAND}"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if got != "x-value" {
+		t.Errorf("Expected x-value, got %q", got)
+	}
+}
@@ -0,0 +1,177 @@
+package vtypes
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExtendedDuration is an implementation of TextMarshalUnmarshaler that wraps a
+// time.Duration value which is parsed as by [time.ParseDuration] with the
+// addition of leading day ("d") and week ("w") units (e.g., "2w", "1w2d3h").
+// Days are assumed to always be 24 hours long, and weeks 7 such days, so
+// daylight saving transitions and similar calendar effects are not
+// considered.
+type ExtendedDuration struct {
+	ptr *time.Duration
+}
+
+// MakeExtendedDuration returns an instance of ExtendedDuration.
+func MakeExtendedDuration(ptr *time.Duration) ExtendedDuration {
+	return ExtendedDuration{
+		ptr: ptr,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (d *ExtendedDuration) UnmarshalText(text []byte) error {
+	v, err := parseExtendedDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d.ptr = v
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (d *ExtendedDuration) MarshalText() ([]byte, error) {
+	if d.ptr == nil {
+		return nil, nil
+	}
+	return []byte(formatExtendedDuration(*d.ptr)), nil
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (d *ExtendedDuration) ValueTypeName() string {
+	return "duration"
+}
+
+// DefaultValueText returns the wrapped value using day and week units where
+// possible, without zero components (e.g., "1w2d3h" rather than "1w2d3h0m0s").
+func (d *ExtendedDuration) DefaultValueText() string {
+	if d.ptr == nil {
+		return ""
+	}
+	return formatExtendedDuration(*d.ptr)
+}
+
+const (
+	extDay  = 24 * time.Hour
+	extWeek = 7 * extDay
+)
+
+func parseExtendedDuration(s string) (time.Duration, error) {
+	invalid := fmt.Errorf("%w: invalid extended duration %q", ErrValueUnsupported, s)
+	overflow := fmt.Errorf("%w: extended duration %q overflows", ErrOutOfRange, s)
+
+	rest := s
+	neg := false
+	if rest != "" && (rest[0] == '-' || rest[0] == '+') {
+		neg = rest[0] == '-'
+		rest = rest[1:]
+	}
+
+	// The magnitude is summed so that the minimum duration can be reached
+	limit := uint64(math.MaxInt64)
+	if neg {
+		limit++
+	}
+	var total uint64
+	add := func(v time.Duration) bool {
+		if uint64(v) > limit-total {
+			return false
+		}
+		total += uint64(v)
+		return true
+	}
+
+	extended := false
+	for {
+		i := 0
+		for i < len(rest) && (rest[i] >= '0' && rest[i] <= '9' || rest[i] == '.') {
+			i++
+		}
+		if i == 0 || i == len(rest) || (rest[i] != 'd' && rest[i] != 'w') {
+			break
+		}
+
+		num := rest[:i]
+		if num[0] == '.' {
+			num = "0" + num
+		}
+		unit := extDay
+		if rest[i] == 'w' {
+			unit = extWeek
+		}
+		v, _, err := parseISOComponent(num, unit)
+		if errors.Is(err, ErrOutOfRange) {
+			return 0, overflow
+		}
+		if err != nil {
+			return 0, invalid
+		}
+		if !add(v) {
+			return 0, overflow
+		}
+		extended = true
+		rest = rest[i+1:]
+	}
+
+	if !extended {
+		if rest == "" || rest[0] == '-' || rest[0] == '+' {
+			return 0, invalid
+		}
+		v, err := time.ParseDuration(s) // Handles the sign, including the minimum
+		if err != nil {
+			return 0, fmt.Errorf("%w: %w", invalid, err)
+		}
+		return v, nil
+	}
+
+	if rest != "" {
+		if rest[0] == '-' || rest[0] == '+' {
+			return 0, invalid
+		}
+		v, err := time.ParseDuration(rest)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %w", invalid, err)
+		}
+		if !add(v) {
+			return 0, overflow
+		}
+	}
+
+	if neg {
+		return time.Duration(-total), nil
+	}
+	return time.Duration(total), nil
+}
+
+func formatExtendedDuration(d time.Duration) string {
+	if d == 0 {
+		return "0s"
+	}
+
+	var b strings.Builder
+	u := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		u = -u
+	}
+
+	w, days := u/uint64(extWeek), u%uint64(extWeek)/uint64(extDay)
+	if w > 0 {
+		b.WriteString(strconv.FormatUint(w, 10) + "w")
+	}
+	if days > 0 {
+		b.WriteString(strconv.FormatUint(days, 10) + "d")
+	}
+	if rem := u % uint64(extDay); rem > 0 {
+		b.WriteString(compactDuration(time.Duration(rem)))
+	}
+
+	return b.String()
+}
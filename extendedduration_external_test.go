@@ -0,0 +1,104 @@
+package vtypes_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/daved/vtypes"
+)
+
+func TestExtendedDuration(t *testing.T) {
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name    string
+		raw     string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "days", raw: "7d", want: 7 * day},
+		{name: "weeks", raw: "2w", want: 14 * day},
+		{name: "combined", raw: "1w2d3h", want: 9*day + 3*time.Hour},
+		{name: "days and minutes", raw: "1d30m", want: day + 30*time.Minute},
+		{name: "fraction", raw: "1.5d", want: 36 * time.Hour},
+		{name: "standard", raw: "90m", want: 90 * time.Minute},
+		{name: "zero", raw: "0", want: 0},
+		{name: "negative", raw: "-1d2h", want: -(day + 2*time.Hour)},
+		{name: "plus", raw: "+1w", want: 7 * day},
+		{name: "empty", raw: "", wantErr: true},
+		{name: "unit only", raw: "d", wantErr: true},
+		{name: "days after hours", raw: "3h1d", wantErr: true},
+		{name: "inner sign", raw: "1d-3h", wantErr: true},
+		{name: "bad unit", raw: "1d3x", wantErr: true},
+		{name: "nanosecond precision", raw: "2600h0m0.000000001s", want: 9360000000000001},
+		{name: "days and nanosecond", raw: "200d1ns", want: 200*day + 1},
+		{name: "week and nanosecond", raw: "1w1ns", want: 7*day + 1},
+		{name: "max", raw: "2562047h47m16.854775807s", want: math.MaxInt64},
+		{name: "min", raw: "-2562047h47m16.854775808s", want: math.MinInt64},
+		{name: "max extended", raw: "15250w1d23h47m16.854775807s", want: math.MaxInt64},
+		{name: "min extended", raw: "-15250w1d23h47m16.854775808s", want: math.MinInt64},
+		{name: "overflow", raw: "99999999w", wantErr: true},
+		{name: "overflow by one", raw: "15250w1d23h47m16.854775808s", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := time.Duration(-1)
+			if tt.wantErr {
+				tt.want = got
+			}
+			dv := vtypes.MakeExtendedDuration(&got)
+
+			err := vtypes.Hydrate(&dv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				var herr *vtypes.HydrateError
+				if !errors.As(err, &herr) {
+					t.Errorf("Expected *vtypes.HydrateError in chain, got %v", err)
+				}
+			}
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestExtendedDurationText(t *testing.T) {
+	day := 24 * time.Hour
+
+	tests := []struct {
+		val  time.Duration
+		want string
+	}{
+		{val: 0, want: "0s"},
+		{val: 90 * time.Minute, want: "1h30m"},
+		{val: 7 * day, want: "1w"},
+		{val: 9*day + 3*time.Hour, want: "1w2d3h"},
+		{val: day + 5*time.Second, want: "1d5s"},
+		{val: -(day + time.Second), want: "-1d1s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := tt.val
+			dv := vtypes.MakeExtendedDuration(&got)
+
+			if text := vtypes.DefaultValueText(&dv); text != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, text)
+			}
+
+			text, err := dv.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText error: %v", err)
+			}
+			if err := dv.UnmarshalText(text); err != nil || got != tt.val {
+				t.Errorf("Expected round trip to %v, got %v (err: %v)", tt.val, got, err)
+			}
+		})
+	}
+}
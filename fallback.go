@@ -0,0 +1,49 @@
+package vtypes
+
+import (
+	"context"
+	"errors"
+)
+
+// Fallback is an implementation of TextMarshalUnmarshaler that attempts to
+// hydrate each of its wrapped values in order, stopping at the first success.
+// If every attempt fails, the errors of all attempts are joined.
+type Fallback struct {
+	ptrs []any
+}
+
+// MakeFallback returns an instance of Fallback. The primary value is used for
+// ValueTypeName and MarshalText.
+func MakeFallback(primary any, alternatives ...any) Fallback {
+	return Fallback{
+		ptrs: append([]any{primary}, alternatives...),
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (f *Fallback) UnmarshalText(text []byte) error {
+	return f.unmarshalTextContext(context.Background(), text)
+}
+
+// unmarshalTextContext is like UnmarshalText, but hydrates the wrapped values using ctx.
+func (f *Fallback) unmarshalTextContext(ctx context.Context, text []byte) error {
+	errs := make([]error, 0, len(f.ptrs))
+	for _, ptr := range f.ptrs {
+		err := hydrate(ctx, ptr, string(text))
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (f *Fallback) MarshalText() ([]byte, error) {
+	return []byte(DefaultValueText(f.ptrs[0])), nil
+}
+
+// ValueTypeName returns the type name of the primary value.
+func (f *Fallback) ValueTypeName() string {
+	return ValueTypeName(f.ptrs[0])
+}
@@ -0,0 +1,66 @@
+package vtypes_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/daved/vtypes"
+)
+
+func TestFallback(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "primary", raw: "30s", want: 30 * time.Second},
+		{name: "alternative", raw: "45", want: 45 * time.Second},
+		{name: "neither", raw: "soon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got time.Duration
+			seconds := vtypes.OnSetFunc(func(s string) error {
+				n, err := strconv.Atoi(s)
+				if err != nil {
+					return err
+				}
+				got = time.Duration(n) * time.Second
+				return nil
+			})
+			fv := vtypes.MakeFallback(&got, &seconds)
+
+			err := vtypes.Hydrate(&fv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+			if err != nil && !errors.Is(err, strconv.ErrSyntax) {
+				t.Errorf("Expected strconv.ErrSyntax from the alternative in chain, got %v", err)
+			}
+			if err != nil && strings.Count(err.Error(), "vtypes: hydrate") != 1 {
+				t.Errorf("Expected a single hydrate error prefix, got %v", err)
+			}
+		})
+	}
+}
+
+func TestFallbackText(t *testing.T) {
+	got := time.Minute
+	var n int
+	fv := vtypes.MakeFallback(&got, &n)
+
+	if name := vtypes.ValueTypeName(&fv); name != "Duration" {
+		t.Errorf("Expected type name Duration, got %q", name)
+	}
+	if text := vtypes.DefaultValueText(&fv); text != "1m0s" {
+		t.Errorf("Expected default text 1m0s, got %q", text)
+	}
+}
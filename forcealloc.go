@@ -0,0 +1,67 @@
+package vtypes
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// ForceAlloc is an implementation of TextMarshalUnmarshaler that wraps a
+// pointer to a pointer (e.g., **int). Non-empty values are hydrated as by
+// [Hydrate]. Empty values allocate each inner pointer (e.g., both levels of a
+// ***int) ending in the zero value of the base type rather than being parsed,
+// so that downstream code which distinguishes nil from zero sees non-nil
+// pointers.
+type ForceAlloc struct {
+	ptr any
+}
+
+// MakeForceAlloc returns an instance of ForceAlloc. The ptr argument must be
+// a pointer to a pointer.
+func MakeForceAlloc(ptr any) ForceAlloc {
+	return ForceAlloc{
+		ptr: ptr,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (f *ForceAlloc) UnmarshalText(text []byte) error {
+	return f.unmarshalTextContext(context.Background(), text)
+}
+
+// unmarshalTextContext is like UnmarshalText, but hydrates the wrapped value using ctx.
+func (f *ForceAlloc) unmarshalTextContext(ctx context.Context, text []byte) error {
+	rv := reflect.ValueOf(f.ptr)
+	if !rv.IsValid() || rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return ErrNotPointer
+	}
+	if rv.Elem().Kind() != reflect.Pointer {
+		return fmt.Errorf("%w: force alloc requires a pointer to a pointer, got %T", ErrTypeUnsupported, f.ptr)
+	}
+
+	if len(text) > 0 {
+		return hydrate(ctx, f.ptr, string(text))
+	}
+
+	// Clear the inner pointer so that the whole chain is freshly allocated
+	// and ends in the zero value
+	rv.Elem().Set(reflect.Zero(rv.Elem().Type()))
+	tmpVal, pointerChain, err := tempValue(f.ptr)
+	if err != nil {
+		return err
+	}
+	return assignThroughChain(tmpVal, pointerChain)
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (f *ForceAlloc) MarshalText() ([]byte, error) {
+	if f.ptr == nil {
+		return nil, nil
+	}
+	return []byte(DefaultValueText(f.ptr)), nil
+}
+
+// ValueTypeName returns the type name of the wrapped value.
+func (f *ForceAlloc) ValueTypeName() string {
+	return ValueTypeName(f.ptr)
+}
@@ -0,0 +1,69 @@
+package vtypes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestForceAlloc(t *testing.T) {
+	tests := []struct {
+		name    string
+		init    *int
+		raw     string
+		want    *int
+		wantErr bool
+	}{
+		{name: "empty allocates zero", init: nil, raw: "", want: ptr(0)},
+		{name: "empty resets to zero", init: ptr(7), raw: "", want: ptr(0)},
+		{name: "value", init: nil, raw: "42", want: ptr(42)},
+		{name: "invalid", init: nil, raw: "x", want: ptr(0), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.init
+			fv := vtypes.MakeForceAlloc(&got)
+
+			err := vtypes.Hydrate(&fv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got == nil || *got != *tt.want {
+				t.Errorf("Expected %v, got %v", *tt.want, got)
+			}
+		})
+	}
+
+	var s *string
+	fv := vtypes.MakeForceAlloc(&s)
+	if err := vtypes.Hydrate(&fv, ""); err != nil || s == nil || *s != "" {
+		t.Errorf("Expected allocated empty string, got %v (err: %v)", s, err)
+	}
+	if name := vtypes.ValueTypeName(&fv); name != "string" {
+		t.Errorf("Expected type name string, got %q", name)
+	}
+
+	pp := ptr(ptr(3))
+	shared := *pp
+	fv = vtypes.MakeForceAlloc(&pp)
+	if err := vtypes.Hydrate(&fv, ""); err != nil || pp == nil || *pp == nil || **pp != 0 {
+		t.Errorf("Expected fully allocated zero **int, got %v (err: %v)", pp, err)
+	}
+	if *shared != 3 {
+		t.Errorf("Expected previously held value to be untouched, got %d", *shared)
+	}
+
+	var np **int
+	fv = vtypes.MakeForceAlloc(&np)
+	if err := vtypes.Hydrate(&fv, ""); err != nil || np == nil || *np == nil || **np != 0 {
+		t.Errorf("Expected fully allocated zero **int, got %v (err: %v)", np, err)
+	}
+
+	var n int
+	fv = vtypes.MakeForceAlloc(&n)
+	if err := vtypes.Hydrate(&fv, ""); !errors.Is(err, vtypes.ErrTypeUnsupported) {
+		t.Errorf("Expected ErrTypeUnsupported for single pointer, got %v", err)
+	}
+}
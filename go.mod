@@ -1,3 +1,3 @@
 module github.com/daved/vtypes
 
-go 1.18
+go 1.20
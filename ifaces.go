@@ -1,6 +1,8 @@
 package vtypes
 
 import (
+	"context"
+	"database/sql/driver"
 	"encoding"
 	"fmt"
 )
@@ -20,12 +22,41 @@ type OnSetter interface {
 	IsBool() bool
 }
 
+// Setter describes types that are set by a string value.
+type Setter interface {
+	Set(val string) error
+}
+
 // StringSetter describes types that are set by and expressed as a string value.
+// Its method set matches [flag.Value], so any flag.Value is a StringSetter.
 type StringSetter interface {
-	Set(val string) error
+	Setter
 	fmt.Stringer
 }
 
+// ContextSetter describes types that are set by a string value and which
+// accept a context (e.g., to support cancellation of network lookups).
+type ContextSetter interface {
+	SetContext(ctx context.Context, val string) error
+}
+
+// contextUnmarshaler is implemented by the containers and wrappers of this
+// package so that the context given to [HydrateContext] reaches the values
+// they hydrate.
+type contextUnmarshaler interface {
+	unmarshalTextContext(ctx context.Context, text []byte) error
+}
+
+// scanner matches [database/sql.Scanner] without importing database/sql.
+type scanner interface {
+	Scan(src any) error
+}
+
+// valuer matches [database/sql/driver.Valuer].
+type valuer interface {
+	Value() (driver.Value, error)
+}
+
 type ValueTypeNamer interface {
 	ValueTypeName() string
 }
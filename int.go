@@ -0,0 +1,93 @@
+package vtypes
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// Int is an implementation of TextMarshalUnmarshaler that wraps a pointer to
+// any builtin signed or unsigned integer type, parsing text using Base. A Base
+// of 0 enables automatic detection of "0x", "0o", and "0b" prefixes (along
+// with underscores), as described by [strconv.ParseInt].
+type Int struct {
+	ptr any
+
+	Base int
+}
+
+// MakeInt returns an instance of Int. Valid ptr type values are *int, *int8,
+// *int16, *int32, *int64, *uint, *uint8, *uint16, *uint32, and *uint64.
+func MakeInt(ptr any, base int) Int {
+	return Int{
+		ptr:  ptr,
+		Base: base,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (n *Int) UnmarshalText(text []byte) error {
+	rv, err := n.value()
+	if err != nil {
+		return err
+	}
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(string(text), n.Base, rv.Type().Bits())
+		if err != nil {
+			return intRangeError(err, string(text), rv.Kind())
+		}
+		rv.SetInt(i)
+
+	default:
+		u, err := strconv.ParseUint(string(text), n.Base, rv.Type().Bits())
+		if err != nil {
+			return intRangeError(err, string(text), rv.Kind())
+		}
+		rv.SetUint(u)
+	}
+
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler]. Text is formatted using Base,
+// or base 10 if Base is 0.
+func (n *Int) MarshalText() ([]byte, error) {
+	rv, err := n.value()
+	if err != nil {
+		return nil, err
+	}
+
+	base := n.Base
+	if base == 0 {
+		base = 10
+	}
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return []byte(strconv.FormatInt(rv.Int(), base)), nil
+	default:
+		return []byte(strconv.FormatUint(rv.Uint(), base)), nil
+	}
+}
+
+// ValueTypeName returns the name of the wrapped integer type.
+func (n *Int) ValueTypeName() string {
+	return ValueTypeName(n.ptr)
+}
+
+func (n *Int) value() (reflect.Value, error) {
+	rv := reflect.ValueOf(n.ptr)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return reflect.Value{}, ErrTypeUnsupported
+	}
+
+	rv = rv.Elem()
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return rv, nil
+	default:
+		return reflect.Value{}, ErrTypeUnsupported
+	}
+}
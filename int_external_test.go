@@ -0,0 +1,64 @@
+package vtypes_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestInt(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   any
+		base    int
+		raw     string
+		want    any
+		wantErr bool
+	}{
+		{name: "base 0 hex", input: new(int), raw: "0xff", want: ptr(255)},
+		{name: "base 0 octal", input: new(int), raw: "0o17", want: ptr(15)},
+		{name: "base 0 binary", input: new(int), raw: "0b101", want: ptr(5)},
+		{name: "base 0 decimal", input: new(int), raw: "-42", want: ptr(-42)},
+		{name: "base 16", input: new(int64), base: 16, raw: "ff", want: ptr(int64(255))},
+		{name: "base 0 uint8", input: new(uint8), raw: "0xff", want: ptr(uint8(255))},
+		{name: "base 10 rejects prefix", input: new(int), base: 10, raw: "0xff", wantErr: true},
+		{name: "overflow", input: new(int8), raw: "0xff", wantErr: true},
+		{name: "unsupported", input: new(string), raw: "1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iv := vtypes.MakeInt(tt.input, tt.base)
+
+			err := vtypes.Hydrate(&iv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(tt.input, tt.want) {
+				t.Errorf("Expected %v, got %v", reflect.ValueOf(tt.want).Elem(), reflect.ValueOf(tt.input).Elem())
+			}
+		})
+	}
+}
+
+func TestIntText(t *testing.T) {
+	n := 255
+	iv := vtypes.MakeInt(&n, 16)
+
+	if name := vtypes.ValueTypeName(&iv); name != "int" {
+		t.Errorf("Expected type name int, got %q", name)
+	}
+	if text := vtypes.DefaultValueText(&iv); text != "ff" {
+		t.Errorf("Expected default text ff, got %q", text)
+	}
+
+	sv := vtypes.MakeInt(new(string), 0)
+	if err := vtypes.Hydrate(&sv, "1"); !errors.Is(err, vtypes.ErrTypeUnsupported) {
+		t.Errorf("Expected ErrTypeUnsupported, got %v", err)
+	}
+}
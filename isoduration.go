@@ -0,0 +1,190 @@
+package vtypes
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ISODuration is an implementation of TextMarshalUnmarshaler that wraps a
+// time.Duration value which is parsed from an ISO8601 duration (e.g.,
+// "PT1H30M" or "P1DT12H"). An optional leading sign is accepted, and the last
+// component may have a fraction using "." or ",".
+//
+// Calendar components are approximated using fixed lengths: a day is 24
+// hours, a week is 7 days, a month is 30 days, and a year is 365 days.
+// MarshalText only emits hours, minutes, and seconds (e.g., "PT36H") so that
+// formatted values do not depend on these approximations.
+type ISODuration struct {
+	ptr *time.Duration
+}
+
+// MakeISODuration returns an instance of ISODuration.
+func MakeISODuration(ptr *time.Duration) ISODuration {
+	return ISODuration{
+		ptr: ptr,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (d *ISODuration) UnmarshalText(text []byte) error {
+	v, err := parseISODuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d.ptr = v
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (d *ISODuration) MarshalText() ([]byte, error) {
+	if d.ptr == nil {
+		return nil, nil
+	}
+	return []byte(formatISODuration(*d.ptr)), nil
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (d *ISODuration) ValueTypeName() string {
+	return "duration"
+}
+
+const (
+	isoDay   = 24 * time.Hour
+	isoWeek  = 7 * isoDay
+	isoMonth = 30 * isoDay
+	isoYear  = 365 * isoDay
+)
+
+var (
+	isoDateDesignators = "YMWD"
+	isoDateUnits       = []time.Duration{isoYear, isoMonth, isoWeek, isoDay}
+	isoTimeDesignators = "HMS"
+	isoTimeUnits       = []time.Duration{time.Hour, time.Minute, time.Second}
+)
+
+func parseISODuration(s string) (time.Duration, error) {
+	invalid := fmt.Errorf("%w: invalid iso8601 duration %q", ErrValueUnsupported, s)
+
+	rest := s
+	neg := false
+	if len(rest) > 0 && (rest[0] == '-' || rest[0] == '+') {
+		neg = rest[0] == '-'
+		rest = rest[1:]
+	}
+	if len(rest) < 2 || rest[0] != 'P' {
+		return 0, invalid
+	}
+	rest = rest[1:]
+
+	designators, units := isoDateDesignators, isoDateUnits
+	inTime, last, fraction := false, -1, false
+
+	var total time.Duration
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime || len(rest) == 1 {
+				return 0, invalid
+			}
+			designators, units = isoTimeDesignators, isoTimeUnits
+			inTime, last = true, -1
+			rest = rest[1:]
+			continue
+		}
+		if fraction {
+			return 0, invalid // only the last component may have a fraction
+		}
+
+		i := 0
+		for i < len(rest) && (rest[i] >= '0' && rest[i] <= '9' || rest[i] == '.' || rest[i] == ',') {
+			i++
+		}
+		if i == 0 || i == len(rest) {
+			return 0, invalid
+		}
+		num := rest[:i]
+
+		idx := strings.IndexByte(designators, rest[i])
+		if idx <= last {
+			return 0, invalid // unknown, repeated, or out of order
+		}
+		last = idx
+		rest = rest[i+1:]
+
+		v, frac, err := parseISOComponent(num, units[idx])
+		if err != nil {
+			return 0, invalid
+		}
+		fraction = frac
+		if total > math.MaxInt64-v {
+			return 0, fmt.Errorf("%w: iso8601 duration %q overflows", ErrOutOfRange, s)
+		}
+		total += v
+	}
+
+	if neg {
+		total = -total
+	}
+	return total, nil
+}
+
+// parseISOComponent returns num multiplied by unit, and whether num has a
+// fraction.
+func parseISOComponent(num string, unit time.Duration) (time.Duration, bool, error) {
+	whole, frac, hasFrac := strings.Cut(strings.Replace(num, ",", ".", 1), ".")
+	if whole == "" || (hasFrac && frac == "") {
+		return 0, false, ErrValueUnsupported
+	}
+
+	n, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || n > math.MaxInt64/int64(unit) {
+		return 0, false, ErrOutOfRange
+	}
+	v := time.Duration(n) * unit
+
+	if hasFrac {
+		f, err := strconv.ParseFloat("0."+frac, 64)
+		if err != nil {
+			return 0, false, err
+		}
+		fv := time.Duration(math.Round(f * float64(unit)))
+		if v > math.MaxInt64-fv {
+			return 0, false, ErrOutOfRange
+		}
+		v += fv
+	}
+
+	return v, hasFrac, nil
+}
+
+func formatISODuration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+	u := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		u = -u
+	}
+	b.WriteString("PT")
+
+	if h := u / uint64(time.Hour); h > 0 {
+		b.WriteString(strconv.FormatUint(h, 10) + "H")
+	}
+	if m := u / uint64(time.Minute) % 60; m > 0 {
+		b.WriteString(strconv.FormatUint(m, 10) + "M")
+	}
+	if ns := u % uint64(time.Minute); ns > 0 {
+		secs := strconv.FormatUint(ns/uint64(time.Second), 10)
+		if frac := ns % uint64(time.Second); frac > 0 {
+			secs += "." + strings.TrimRight(fmt.Sprintf("%09d", frac), "0")
+		}
+		b.WriteString(secs + "S")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,117 @@
+package vtypes_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/daved/vtypes"
+)
+
+func TestISODuration(t *testing.T) {
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name    string
+		raw     string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "seconds", raw: "PT30S", want: 30 * time.Second},
+		{name: "hours", raw: "PT1H", want: time.Hour},
+		{name: "hours minutes", raw: "PT1H30M", want: 90 * time.Minute},
+		{name: "combined", raw: "P1DT2H3M4S", want: day + 2*time.Hour + 3*time.Minute + 4*time.Second},
+		{name: "weeks", raw: "P2W", want: 14 * day},
+		{name: "months", raw: "P1M", want: 30 * day},
+		{name: "years", raw: "P1Y", want: 365 * day},
+		{name: "minutes vs months", raw: "P1MT1M", want: 30*day + time.Minute},
+		{name: "fraction", raw: "PT1.5S", want: 1500 * time.Millisecond},
+		{name: "comma fraction", raw: "PT0,25H", want: 15 * time.Minute},
+		{name: "negative", raw: "-PT1M", want: -time.Minute},
+		{name: "plus", raw: "+PT1M", want: time.Minute},
+		{name: "zero", raw: "PT0S", want: 0},
+		{name: "empty", raw: "", wantErr: true},
+		{name: "missing P", raw: "T1H", wantErr: true},
+		{name: "P only", raw: "P", wantErr: true},
+		{name: "trailing T", raw: "P1DT", wantErr: true},
+		{name: "time unit without T", raw: "P1H", wantErr: true},
+		{name: "out of order", raw: "PT1S1H", wantErr: true},
+		{name: "repeated", raw: "PT1H1H", wantErr: true},
+		{name: "missing designator", raw: "PT1", wantErr: true},
+		{name: "missing number", raw: "PTH", wantErr: true},
+		{name: "fraction not last", raw: "PT1.5H1M", wantErr: true},
+		{name: "go duration", raw: "1h30m", wantErr: true},
+		{name: "overflow", raw: "P999999Y", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := time.Duration(-1)
+			if tt.wantErr {
+				tt.want = got
+			}
+			dv := vtypes.MakeISODuration(&got)
+
+			err := vtypes.Hydrate(&dv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				var herr *vtypes.HydrateError
+				if !errors.As(err, &herr) {
+					t.Errorf("Expected *vtypes.HydrateError in chain, got %v", err)
+				}
+			}
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestISODurationText(t *testing.T) {
+	tests := []struct {
+		val  time.Duration
+		want string
+	}{
+		{val: 0, want: "PT0S"},
+		{val: 30 * time.Second, want: "PT30S"},
+		{val: 90 * time.Minute, want: "PT1H30M"},
+		{val: 36 * time.Hour, want: "PT36H"},
+		{val: 1500 * time.Millisecond, want: "PT1.5S"},
+		{val: -time.Minute, want: "-PT1M"},
+		{val: math.MinInt64, want: "-PT2562047H47M16.854775808S"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := tt.val
+			dv := vtypes.MakeISODuration(&got)
+
+			text, err := dv.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText error: %v", err)
+			}
+			if string(text) != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, text)
+			}
+			if text := vtypes.DefaultValueText(&dv); text != tt.want {
+				t.Errorf("Expected default text %q, got %q", tt.want, text)
+			}
+
+			if tt.val == math.MinInt64 {
+				return // the magnitude cannot be represented when parsing
+			}
+			if err := dv.UnmarshalText(text); err != nil || got != tt.val {
+				t.Errorf("Expected round trip to %v, got %v (err: %v)", tt.val, got, err)
+			}
+		})
+	}
+
+	var d time.Duration
+	dv := vtypes.MakeISODuration(&d)
+	if name := vtypes.ValueTypeName(&dv); name != "duration" {
+		t.Errorf("Expected type name duration, got %q", name)
+	}
+}
@@ -0,0 +1,32 @@
+package vtypes
+
+import "encoding/json"
+
+// JSON is an implementation of TextMarshalUnmarshaler that wraps any value
+// supported by [json.Unmarshal] and [json.Marshal].
+type JSON struct {
+	ptr any
+}
+
+// MakeJSON returns an instance of JSON.
+func MakeJSON(ptr any) JSON {
+	return JSON{
+		ptr: ptr,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (j *JSON) UnmarshalText(text []byte) error {
+	return json.Unmarshal(text, j.ptr)
+}
+
+// MarshalText implements [encoding.TextMarshaler]. The resulting JSON is
+// compact.
+func (j *JSON) MarshalText() ([]byte, error) {
+	return json.Marshal(j.ptr)
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (j *JSON) ValueTypeName() string {
+	return "json"
+}
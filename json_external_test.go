@@ -0,0 +1,55 @@
+package vtypes_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+type endpoint struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
+
+func TestJSON(t *testing.T) {
+	var got endpoint
+	jv := vtypes.MakeJSON(&got)
+
+	if err := vtypes.Hydrate(&jv, `{ "host": "localhost", "port": 8080 }`); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if want := (endpoint{Host: "localhost", Port: 8080}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	if text := vtypes.DefaultValueText(&jv); text != `{"host":"localhost","port":8080}` {
+		t.Errorf("Expected compact default text, got %q", text)
+	}
+	if name := vtypes.ValueTypeName(&jv); name != "json" {
+		t.Errorf("Expected type name json, got %q", name)
+	}
+}
+
+func TestJSONErrors(t *testing.T) {
+	var got endpoint
+	jv := vtypes.MakeJSON(&got)
+
+	err := vtypes.Hydrate(&jv, `{"host":`)
+	var serr *json.SyntaxError
+	if !errors.As(err, &serr) {
+		t.Errorf("Expected *json.SyntaxError in chain, got %v", err)
+	}
+
+	err = vtypes.Hydrate(&jv, `{"port":"8080"}`)
+	var terr *json.UnmarshalTypeError
+	if !errors.As(err, &terr) {
+		t.Errorf("Expected *json.UnmarshalTypeError in chain, got %v", err)
+	}
+	var herr *vtypes.HydrateError
+	if !errors.As(err, &herr) {
+		t.Errorf("Expected *vtypes.HydrateError in chain, got %v", err)
+	}
+}
@@ -0,0 +1,38 @@
+package vtypes
+
+import (
+	"bytes"
+	"context"
+)
+
+// Lines is an implementation of TextMarshalUnmarshaler that wraps a string
+// slice value which is split into lines. Line endings may be "\n" or "\r\n",
+// and empty lines are skipped. Each UnmarshalText call accumulates or replaces
+// values as configured by the embedded [Slice].
+type Lines struct {
+	Slice
+}
+
+// MakeLines returns an instance of Lines.
+func MakeLines(ptr *[]string) Lines {
+	return Lines{
+		Slice: MakeSlice(ptr, WithSeparator("\n")),
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (l *Lines) UnmarshalText(text []byte) error {
+	return l.unmarshalTextContext(context.Background(), text)
+}
+
+// unmarshalTextContext is like UnmarshalText, but hydrates elements using ctx.
+func (l *Lines) unmarshalTextContext(ctx context.Context, text []byte) error {
+	text = bytes.ReplaceAll(text, []byte("\r\n"), []byte("\n"))
+	text = bytes.TrimSuffix(text, []byte("\r"))
+	return l.Slice.unmarshalTextContext(ctx, text)
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (l *Lines) ValueTypeName() string {
+	return "lines"
+}
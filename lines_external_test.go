@@ -0,0 +1,65 @@
+package vtypes_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		raws     []string
+		nonAccum bool
+		want     []string
+	}{
+		{name: "unix", raws: []string{"a\nb c\n"}, want: []string{"a", "b c"}},
+		{name: "windows", raws: []string{"a\r\nb\r\n\r\nc\r"}, want: []string{"a", "b", "c"}},
+		{name: "commas kept", raws: []string{"a,b\nc"}, want: []string{"a,b", "c"}},
+		{name: "accumulate", raws: []string{"a\nb", "c"}, want: []string{"a", "b", "c"}},
+		{name: "replace", raws: []string{"a\nb", "c"}, nonAccum: true, want: []string{"c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{"held"}
+			lv := vtypes.MakeLines(&got)
+			lv.NonAccum = tt.nonAccum
+
+			for _, raw := range tt.raws {
+				if err := vtypes.Hydrate(&lv, raw); err != nil {
+					t.Fatalf("Hydrate error: %v", err)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLinesText(t *testing.T) {
+	got := []string{"a", "b"}
+	lv := vtypes.MakeLines(&got)
+
+	if name := vtypes.ValueTypeName(&lv); name != "lines" {
+		t.Errorf("Expected type name lines, got %q", name)
+	}
+	if text := vtypes.DefaultValueText(&lv); text != "a\nb" {
+		t.Errorf("Expected default text %q, got %q", "a\nb", text)
+	}
+}
+
+func TestLinesReadFrom(t *testing.T) {
+	var vals []string
+	l := vtypes.MakeLines(&vals)
+
+	if _, err := l.ReadFrom(strings.NewReader("a\r\n\r\nb\r\n")); err != nil {
+		t.Fatalf("ReadFrom error: %v", err)
+	}
+	if want := []string{"a", "b"}; !reflect.DeepEqual(vals, want) {
+		t.Errorf("Expected %q, got %q", want, vals)
+	}
+}
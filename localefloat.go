@@ -0,0 +1,73 @@
+package vtypes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// LocaleFloat is an implementation of TextMarshalUnmarshaler that wraps a
+// float64 value which is written using locale-specific separators (e.g.,
+// "1.234,56" with a decimal separator of ',' and a group separator of '.').
+// Group separators are removed and the decimal separator is converted to '.'
+// before parsing. A GroupSep of zero disables grouping.
+//
+// LocaleFloat is opt-in; plain *float64 values are always parsed using
+// [strconv.ParseFloat].
+type LocaleFloat struct {
+	ptr *float64
+
+	DecimalSep rune
+	GroupSep   rune
+}
+
+// MakeLocaleFloat returns an instance of LocaleFloat.
+func MakeLocaleFloat(ptr *float64, decimalSep, groupSep rune) LocaleFloat {
+	return LocaleFloat{
+		ptr:        ptr,
+		DecimalSep: decimalSep,
+		GroupSep:   groupSep,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler]. Text containing a '.'
+// that is neither the decimal nor group separator is rejected so that values
+// written for another locale are not silently misread.
+func (f *LocaleFloat) UnmarshalText(text []byte) error {
+	if f.DecimalSep == f.GroupSep {
+		return fmt.Errorf("%w: decimal and group separators must differ", ErrValueUnsupported)
+	}
+
+	s := string(text)
+	if f.GroupSep != 0 {
+		s = strings.ReplaceAll(s, string(f.GroupSep), "")
+	}
+	if f.DecimalSep != '.' {
+		if strings.ContainsRune(s, '.') {
+			return fmt.Errorf("%w: unexpected '.' in %q", ErrValueUnsupported, text)
+		}
+		s = strings.Replace(s, string(f.DecimalSep), ".", 1)
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return err
+	}
+	*f.ptr = v
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler]. Group separators are not
+// written.
+func (f *LocaleFloat) MarshalText() ([]byte, error) {
+	if f.ptr == nil {
+		return nil, nil
+	}
+	s := strconv.FormatFloat(*f.ptr, 'f', -1, 64)
+	return []byte(strings.Replace(s, ".", string(f.DecimalSep), 1)), nil
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (f *LocaleFloat) ValueTypeName() string {
+	return "float64"
+}
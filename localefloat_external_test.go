@@ -0,0 +1,61 @@
+package vtypes_test
+
+import (
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestLocaleFloat(t *testing.T) {
+	tests := []struct {
+		name       string
+		decimalSep rune
+		groupSep   rune
+		raw        string
+		want       float64
+		wantErr    bool
+	}{
+		{name: "european", decimalSep: ',', groupSep: '.', raw: "1.234,56", want: 1234.56},
+		{name: "european no group", decimalSep: ',', groupSep: '.', raw: "0,5", want: 0.5},
+		{name: "european negative", decimalSep: ',', groupSep: '.', raw: "-1.000.000", want: -1e6},
+		{name: "english", decimalSep: '.', groupSep: ',', raw: "1,234.56", want: 1234.56},
+		{name: "space group", decimalSep: ',', groupSep: ' ', raw: "12 345,6", want: 12345.6},
+		{name: "no grouping", decimalSep: ',', raw: "3,25", want: 3.25},
+		{name: "no grouping with dot", decimalSep: ',', raw: "3.25", want: -1, wantErr: true},
+		{name: "two decimals", decimalSep: ',', groupSep: '.', raw: "1,2,3", want: -1, wantErr: true},
+		{name: "same separators", decimalSep: ',', groupSep: ',', raw: "1", want: -1, wantErr: true},
+		{name: "invalid", decimalSep: ',', groupSep: '.', raw: "abc", want: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := -1.0
+			fv := vtypes.MakeLocaleFloat(&got, tt.decimalSep, tt.groupSep)
+
+			err := vtypes.Hydrate(&fv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLocaleFloatText(t *testing.T) {
+	got := 1234.5
+	fv := vtypes.MakeLocaleFloat(&got, ',', '.')
+
+	if text := vtypes.DefaultValueText(&fv); text != "1234,5" {
+		t.Errorf("Expected default text 1234,5, got %q", text)
+	}
+	if name := vtypes.ValueTypeName(&fv); name != "float64" {
+		t.Errorf("Expected type name float64, got %q", name)
+	}
+
+	var plain float64
+	if err := vtypes.Hydrate(&plain, "1,5"); err == nil {
+		t.Error("Expected plain *float64 to reject locale formatting")
+	}
+}
@@ -0,0 +1,49 @@
+package vtypes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// LooseBool is an implementation of OnSetter that wraps a bool value which is
+// set using common words as well as the values accepted by
+// [strconv.ParseBool]. Values are matched case-insensitively:
+//   - true: "1", "t", "true", "y", "yes", "on", "enable", "enabled"
+//   - false: "0", "f", "false", "n", "no", "off", "disable", "disabled"
+//
+// An empty value sets true, as when a bool flag is provided without a value.
+type LooseBool struct {
+	ptr *bool
+}
+
+// MakeLooseBool returns an instance of LooseBool.
+func MakeLooseBool(ptr *bool) LooseBool {
+	return LooseBool{
+		ptr: ptr,
+	}
+}
+
+// OnSet sets the wrapped value.
+func (b *LooseBool) OnSet(val string) error {
+	switch strings.ToLower(val) {
+	case "", "1", "t", "true", "y", "yes", "on", "enable", "enabled":
+		*b.ptr = true
+	case "0", "f", "false", "n", "no", "off", "disable", "disabled":
+		*b.ptr = false
+	default:
+		return fmt.Errorf("%w: bool %q", ErrValueUnsupported, val)
+	}
+	return nil
+}
+
+// IsBool indicates that the value is intended to be set without a value.
+func (b *LooseBool) IsBool() bool { return true }
+
+// DefaultValueText returns the current value.
+func (b *LooseBool) DefaultValueText() string {
+	if b.ptr == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b.ptr)
+}
@@ -0,0 +1,68 @@
+package vtypes_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestLooseBool(t *testing.T) {
+	trues := []string{"", "1", "t", "T", "true", "TRUE", "True", "y", "Y", "yes", "YES", "on", "On", "enable", "enabled", "ENABLED"}
+	falses := []string{"0", "f", "F", "false", "FALSE", "False", "n", "N", "no", "NO", "off", "OFF", "disable", "disabled", "Disabled"}
+
+	for _, want := range []bool{true, false} {
+		raws := trues
+		if !want {
+			raws = falses
+		}
+		for _, raw := range raws {
+			t.Run(raw, func(t *testing.T) {
+				got := !want
+				bv := vtypes.MakeLooseBool(&got)
+
+				if err := vtypes.Hydrate(&bv, raw); err != nil {
+					t.Fatalf("Hydrate error: %v", err)
+				}
+				if got != want {
+					t.Errorf("Expected %t, got %t", want, got)
+				}
+			})
+		}
+	}
+
+	for _, raw := range []string{"maybe", "yess", " yes", "2", "enabl"} {
+		t.Run(raw, func(t *testing.T) {
+			got := true
+			bv := vtypes.MakeLooseBool(&got)
+
+			err := vtypes.Hydrate(&bv, raw)
+			if !errors.Is(err, vtypes.ErrValueUnsupported) {
+				t.Errorf("Expected ErrValueUnsupported in chain, got %v", err)
+			}
+			if !got {
+				t.Error("Expected value to be untouched")
+			}
+		})
+	}
+}
+
+func TestLooseBoolText(t *testing.T) {
+	got := true
+	bv := vtypes.MakeLooseBool(&got)
+
+	if name := vtypes.ValueTypeName(&bv); name != "bool" {
+		t.Errorf("Expected type name bool, got %q", name)
+	}
+	if text := vtypes.DefaultValueText(&bv); text != "true" {
+		t.Errorf("Expected default text true, got %q", text)
+	}
+
+	var strict bool
+	for _, raw := range []string{"yes", "on", strings.ToUpper("enabled")} {
+		if err := vtypes.Hydrate(&strict, raw); err == nil {
+			t.Errorf("Expected plain *bool to reject %q", raw)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package vtypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Map is an implementation of TextMarshalUnmarshaler that wraps a map value
+// (possibly with multiple levels of pointers) whose key and element types are
+// supported by [Hydrate]. Text is expected in the form "k1=v1,k2=v2", with both
+// separators being configurable. The underlying map is only initialized if
+// values are added; otherwise, nil pointers in the chain remain nil.
+type Map struct {
+	ptrValue any // Stores the original value (e.g., **map[string]int)
+	started  bool
+
+	Separator   string
+	KVSeparator string
+	NonAccum    bool
+
+	// Quotes causes keys and values that begin with a double quote to be read
+	// up to the matching closing quote, so that they may contain either
+	// separator (e.g., `dsn="host=db,port=5432"`). A double quote within a
+	// quoted key or value is escaped by doubling it, as in CSV. Double quotes
+	// elsewhere are treated literally.
+	Quotes bool
+}
+
+// MakeMap returns an instance of Map.
+func MakeMap(ptrToMap any) Map {
+	return Map{
+		ptrValue:    ptrToMap,
+		Separator:   ",", // Default separator between pairs
+		KVSeparator: "=", // Default separator between key and value
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (m *Map) UnmarshalText(text []byte) error {
+	return m.unmarshalTextContext(context.Background(), text)
+}
+
+// unmarshalTextContext is like UnmarshalText, but hydrates keys and values using ctx.
+func (m *Map) unmarshalTextContext(ctx context.Context, text []byte) error {
+	// Preserve nil state if no text
+	if len(text) == 0 {
+		return nil
+	}
+
+	pairs, err := m.split(string(text))
+	if err != nil {
+		return err
+	}
+
+	v := reflect.ValueOf(m.ptrValue)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			// Initialize only if we have values to add
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Map || !v.CanSet() {
+		return errors.New("map: contained value is not a pointer to a map")
+	}
+
+	// Initialize or reset only if necessary
+	if !m.started || m.NonAccum || v.IsNil() {
+		v.Set(reflect.MakeMap(v.Type()))
+	}
+	m.started = true
+
+	keyType, valType := v.Type().Key(), v.Type().Elem()
+
+	for _, pair := range pairs {
+		rawKey, rawVal := pair[0], pair[1]
+
+		elem := fmt.Sprintf("[%q]", rawKey)
+
+		key := reflect.New(keyType)
+		if err := HydrateContext(ctx, key.Interface(), rawKey); err != nil {
+			return NewPathError(fmt.Errorf("key: %w", err), "map", elem)
+		}
+
+		item := reflect.New(valType)
+		if err := HydrateContext(ctx, item.Interface(), rawVal); err != nil {
+			return NewPathError(err, "map", elem)
+		}
+
+		v.SetMapIndex(key.Elem(), item.Elem())
+	}
+
+	return nil
+}
+
+// split returns the raw key and value of each non-empty pair in text.
+func (m *Map) split(text string) ([][2]string, error) {
+	if m.Quotes {
+		return splitQuotedPairs(text, m.Separator, m.KVSeparator)
+	}
+
+	var pairs [][2]string
+	for _, pair := range strings.Split(text, m.Separator) {
+		if len(pair) == 0 {
+			continue // Skip empty pairs
+		}
+
+		rawKey, rawVal, ok := strings.Cut(pair, m.KVSeparator)
+		if !ok {
+			return nil, fmt.Errorf("map: unmarshal text: malformed pair %q", pair)
+		}
+		pairs = append(pairs, [2]string{rawKey, rawVal})
+	}
+	return pairs, nil
+}
+
+// MarshalText implements [encoding.TextMarshaler]. Pairs are sorted by the
+// text of their keys so that the result is deterministic. If Quotes is set,
+// keys and values are quoted when needed for UnmarshalText to reproduce them.
+func (m *Map) MarshalText() ([]byte, error) {
+	v := reflect.ValueOf(m.ptrValue)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, nil // Return nil text for nil pointers
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Map {
+		return nil, errors.New("map: contained value is not a pointer to a map")
+	}
+
+	type pair struct{ k, text string }
+	pairs := make([]pair, 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		k := elemText(iter.Key())
+		e := elemText(iter.Value())
+		if m.Quotes {
+			k = quoteMapText(k, m.Separator, m.KVSeparator)
+			e = quoteMapText(e, m.Separator, m.KVSeparator)
+		}
+		pairs = append(pairs, pair{k, k + m.KVSeparator + e})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].k != pairs[j].k {
+			return pairs[i].k < pairs[j].k
+		}
+		return pairs[i].text < pairs[j].text // Distinct keys may share text (e.g., NaN)
+	})
+
+	out := make([]string, len(pairs))
+	for i, p := range pairs {
+		out[i] = p.text
+	}
+	return []byte(strings.Join(out, m.Separator)), nil
+}
+
+// ValueTypeName returns the name of the underlying map type (e.g.,
+// "map[string]int").
+func (m *Map) ValueTypeName() string {
+	t := reflect.TypeOf(m.ptrValue)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.String()
+}
+
+// Value returns the original value with its pointer chain.
+func (m *Map) Value() any {
+	return m.ptrValue
+}
+
+// splitQuotedPairs splits text into raw keys and values as described by
+// Map.Quotes. Empty pairs are skipped.
+func splitQuotedPairs(text, sep, kvSep string) ([][2]string, error) {
+	var pairs [][2]string
+	for len(text) > 0 {
+		if strings.HasPrefix(text, sep) {
+			text = text[len(sep):] // Skip empty pairs
+			continue
+		}
+
+		key, rest, err := cutQuotedField(text, kvSep, sep)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(rest, kvSep) {
+			pair, _, _ := strings.Cut(text, sep)
+			return nil, fmt.Errorf("map: unmarshal text: malformed pair %q", pair)
+		}
+		rest = rest[len(kvSep):]
+
+		val, rest, err := cutQuotedField(rest, sep)
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, [2]string{key, val})
+
+		text = strings.TrimPrefix(rest, sep)
+	}
+	return pairs, nil
+}
+
+// cutQuotedField returns the (unquoted) field at the start of text, along with
+// the remaining text which begins with the first of stops that follows the
+// field, or is empty.
+func cutQuotedField(text string, stops ...string) (field, rest string, err error) {
+	if !strings.HasPrefix(text, `"`) {
+		end := len(text)
+		for _, stop := range stops {
+			if i := strings.Index(text, stop); i >= 0 && i < end {
+				end = i
+			}
+		}
+		return text[:end], text[end:], nil
+	}
+
+	var b strings.Builder
+	rest = text[1:]
+	for {
+		i := strings.IndexByte(rest, '"')
+		if i < 0 {
+			return "", "", fmt.Errorf("map: unmarshal text: unterminated quote in %q", text)
+		}
+		b.WriteString(rest[:i])
+		rest = rest[i+1:]
+		if !strings.HasPrefix(rest, `"`) {
+			break
+		}
+		b.WriteByte('"') // Doubled quote
+		rest = rest[1:]
+	}
+
+	if rest != "" {
+		atStop := false
+		for _, stop := range stops {
+			atStop = atStop || strings.HasPrefix(rest, stop)
+		}
+		if !atStop {
+			return "", "", fmt.Errorf("map: unmarshal text: unexpected text after quote in %q", text)
+		}
+	}
+	return b.String(), rest, nil
+}
+
+// quoteMapText quotes s if it contains either separator or begins with a
+// double quote.
+func quoteMapText(s, sep, kvSep string) string {
+	if !strings.Contains(s, sep) && !strings.Contains(s, kvSep) && !strings.HasPrefix(s, `"`) {
+		return s
+	}
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
@@ -0,0 +1,263 @@
+package vtypes_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestConvertCompatibleWithPointerMap(t *testing.T) {
+	var m map[string]int
+
+	result := vtypes.ConvertCompatible(&m)
+	mapVal, ok := result.(*vtypes.Map)
+	if !ok {
+		t.Fatalf("Expected *vtypes.Map, got %T", result)
+	}
+
+	if got := mapVal.ValueTypeName(); got != "map[string]int" {
+		t.Errorf("Expected type name map[string]int, got %q", got)
+	}
+
+	if err := mapVal.UnmarshalText([]byte("")); err != nil {
+		t.Errorf("UnmarshalText error: %v", err)
+	}
+	if m != nil {
+		t.Errorf("Expected nil map after empty unmarshal, got %v", m)
+	}
+
+	if err := mapVal.UnmarshalText([]byte("a=1,b=2")); err != nil {
+		t.Errorf("UnmarshalText error: %v", err)
+	}
+	if err := mapVal.UnmarshalText([]byte("c=3")); err != nil {
+		t.Errorf("UnmarshalText error: %v", err)
+	}
+	want := map[string]int{"a": 1, "b": 2, "c": 3}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("Expected map values %v, got %v", want, m)
+	}
+}
+
+func TestMapSeparatorsAndNonAccum(t *testing.T) {
+	var m *map[int]float64 // nil
+
+	mapVal := vtypes.MakeMap(&m)
+	mapVal.Separator = ";"
+	mapVal.KVSeparator = ":"
+	mapVal.NonAccum = true
+
+	if err := mapVal.UnmarshalText([]byte("1:1.5;2:2.5")); err != nil {
+		t.Errorf("UnmarshalText error: %v", err)
+	}
+	if err := mapVal.UnmarshalText([]byte("3:3.5")); err != nil {
+		t.Errorf("UnmarshalText error: %v", err)
+	}
+	if m == nil {
+		t.Fatalf("Expected non-nil *map pointer after unmarshal, got nil")
+	}
+	want := map[int]float64{3: 3.5}
+	if !reflect.DeepEqual(*m, want) {
+		t.Errorf("Expected map values %v, got %v", want, *m)
+	}
+
+	text, err := mapVal.MarshalText()
+	if err != nil {
+		t.Errorf("MarshalText error: %v", err)
+	}
+	if string(text) != "3:3.5" {
+		t.Errorf("Expected text 3:3.5, got %q", text)
+	}
+}
+
+func TestMapUnmarshalTextErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+	}{
+		{name: "missing kv separator", raw: "a=1,b"},
+		{name: "invalid key", raw: "x=1"},
+		{name: "invalid value", raw: "1=x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := map[int]int{}
+			mapVal := vtypes.MakeMap(&m)
+			if err := mapVal.UnmarshalText([]byte(tt.raw)); err == nil {
+				t.Errorf("Expected error for %q, got nil", tt.raw)
+			}
+		})
+	}
+}
+
+func TestHydrateMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    map[string]int
+		wantErr bool
+	}{
+		{name: "pairs", raw: "a=1,b=2", want: map[string]int{"a": 1, "b": 2}},
+		{name: "duplicate keys", raw: "a=1,a=2", want: map[string]int{"a": 2}},
+		{name: "malformed pair", raw: "a=1,b", wantErr: true},
+		{name: "invalid value", raw: "a=x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]int{"old": 0}
+
+			err := vtypes.Hydrate(&got, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected map values %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	var pm *map[int]bool // nil, exercised through a double pointer
+	if err := vtypes.Hydrate(&pm, "1=true"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if want := map[int]bool{1: true}; pm == nil || !reflect.DeepEqual(*pm, want) {
+		t.Errorf("Expected map values %v, got %v", want, pm)
+	}
+}
+
+func TestMapMarshalTextSorted(t *testing.T) {
+	m := map[string]int{}
+	for i := 0; i < 50; i++ {
+		m[fmt.Sprintf("k%02d", 49-i)] = i
+	}
+	mapVal := vtypes.MakeMap(&m)
+
+	first, err := mapVal.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText error: %v", err)
+	}
+	if !strings.HasPrefix(string(first), "k00=49,k01=48,") {
+		t.Errorf("Expected keys in sorted order, got %q", first)
+	}
+	for i := 0; i < 20; i++ {
+		text, err := mapVal.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText error: %v", err)
+		}
+		if string(text) != string(first) {
+			t.Fatalf("Expected identical output across marshals, got %q and %q", first, text)
+		}
+		if got := vtypes.DefaultValueText(&mapVal); got != string(first) {
+			t.Fatalf("Expected identical default text, got %q and %q", first, got)
+		}
+	}
+
+	ints := map[int]bool{10: true, 9: false, -1: true}
+	intsVal := vtypes.MakeMap(&ints)
+	if text, _ := intsVal.MarshalText(); string(text) != "-1=true,10=true,9=false" {
+		t.Errorf("Expected keys sorted by text, got %q", text)
+	}
+}
+
+func TestMapMarshalTextPointerValues(t *testing.T) {
+	m := map[string]*int{"a": ptr(1), "b": nil, "c": ptr(-3)}
+	mapVal := vtypes.MakeMap(&m)
+
+	text, err := mapVal.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText error: %v", err)
+	}
+	if want := "a=1,b=,c=-3"; string(text) != want {
+		t.Errorf("Expected %q, got %q", want, text)
+	}
+
+	got := map[string]*int{}
+	gotVal := vtypes.MakeMap(&got)
+	if err := vtypes.Hydrate(&gotVal, "a=1,c=-3"); err != nil {
+		t.Fatalf("Hydrate() error = %v", err)
+	}
+	if len(got) != 2 || got["a"] == nil || *got["a"] != 1 || got["c"] == nil || *got["c"] != -3 {
+		t.Errorf("Expected round-tripped values, got %v", got)
+	}
+}
+
+func TestMapQuotes(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    map[string]string
+		wantErr bool
+	}{
+		{name: "plain", raw: "a=1,b=2", want: map[string]string{"a": "1", "b": "2"}},
+		{name: "quoted value", raw: `dsn="host=db,port=5432",x=y`, want: map[string]string{"dsn": "host=db,port=5432", "x": "y"}},
+		{name: "quoted key", raw: `"a,b"=1`, want: map[string]string{"a,b": "1"}},
+		{name: "doubled quote", raw: `q="say ""hi"""`, want: map[string]string{"q": `say "hi"`}},
+		{name: "empty quoted", raw: `e="",f=1`, want: map[string]string{"e": "", "f": "1"}},
+		{name: "inner quote literal", raw: `a=x"y`, want: map[string]string{"a": `x"y`}},
+		{name: "unquoted value with kv separator", raw: "a=b=c", want: map[string]string{"a": "b=c"}},
+		{name: "empty pairs", raw: `,a="1",,`, want: map[string]string{"a": "1"}},
+		{name: "unterminated", raw: `a="1,b=2`, wantErr: true},
+		{name: "text after quote", raw: `a="1"x,b=2`, wantErr: true},
+		{name: "missing kv separator", raw: `a=1,"b"`, wantErr: true},
+		{name: "unquoted missing kv separator", raw: "a=1,b", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]string{"old": "x"}
+			mapVal := vtypes.MakeMap(&got)
+			mapVal.Quotes = true
+			mapVal.NonAccum = true
+
+			err := mapVal.UnmarshalText([]byte(tt.raw))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if want := map[string]string{"old": "x"}; !reflect.DeepEqual(got, want) {
+					t.Errorf("Expected map to be untouched on error, got %v", got)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMapQuotesRoundTrip(t *testing.T) {
+	want := map[string]string{
+		"plain":  "v",
+		"a,b":    "c=d",
+		`"q`:     `say "hi"`,
+		"k=v":    "",
+		"spaces": "x y",
+	}
+	got := map[string]string{}
+	for k, v := range want {
+		got[k] = v
+	}
+
+	mapVal := vtypes.MakeMap(&got)
+	mapVal.Quotes = true
+	mapVal.NonAccum = true
+
+	text, err := mapVal.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText error: %v", err)
+	}
+	if err := mapVal.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText error for %q: %v", text, err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %q after round trip of %q, got %q", want, text, got)
+	}
+}
@@ -0,0 +1,82 @@
+package vtypes
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MappedInt is an implementation of StringSetter that wraps an int value which
+// is set using named tokens (e.g., "low", "medium", and "high" mapped to 0, 1,
+// and 2). If CaseInsensitive is set, an exact match is preferred, and
+// otherwise tokens are matched without regard to case. String reports the
+// token of the current value, or the number itself if no token maps to it.
+type MappedInt struct {
+	ptr *int
+
+	Mapping         map[string]int
+	CaseInsensitive bool
+}
+
+// MakeMappedInt returns an instance of MappedInt.
+func MakeMappedInt(ptr *int, mapping map[string]int) MappedInt {
+	return MappedInt{
+		ptr:     ptr,
+		Mapping: mapping,
+	}
+}
+
+// Set implements [StringSetter].
+func (m *MappedInt) Set(val string) error {
+	if n, ok := m.Mapping[val]; ok {
+		*m.ptr = n
+		return nil
+	}
+
+	tokens := m.tokens()
+	if m.CaseInsensitive {
+		for _, token := range tokens {
+			if strings.EqualFold(token, val) {
+				*m.ptr = m.Mapping[token]
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("%w: %q (valid: %s)", ErrValueUnsupported, val, strings.Join(tokens, "|"))
+}
+
+// String implements [fmt.Stringer].
+func (m *MappedInt) String() string {
+	if m.ptr == nil {
+		return ""
+	}
+	for _, token := range m.tokens() {
+		if m.Mapping[token] == *m.ptr {
+			return token
+		}
+	}
+	return strconv.Itoa(*m.ptr)
+}
+
+// ValueTypeName returns the name of the wrapped type along with its tokens
+// (e.g., "enum(low|medium|high)").
+func (m *MappedInt) ValueTypeName() string {
+	return fmt.Sprintf("enum(%s)", strings.Join(m.tokens(), "|"))
+}
+
+// tokens returns the keys of Mapping ordered by value, then by name.
+func (m *MappedInt) tokens() []string {
+	tokens := make([]string, 0, len(m.Mapping))
+	for token := range m.Mapping {
+		tokens = append(tokens, token)
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		a, b := m.Mapping[tokens[i]], m.Mapping[tokens[j]]
+		if a != b {
+			return a < b
+		}
+		return tokens[i] < tokens[j]
+	})
+	return tokens
+}
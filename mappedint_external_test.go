@@ -0,0 +1,64 @@
+package vtypes_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestMappedInt(t *testing.T) {
+	mapping := map[string]int{"low": 0, "medium": 1, "high": 2, "max": 2}
+
+	tests := []struct {
+		name            string
+		caseInsensitive bool
+		raw             string
+		want            int
+		wantErr         bool
+	}{
+		{name: "low", raw: "low", want: 0},
+		{name: "high", raw: "high", want: 2},
+		{name: "alias", raw: "max", want: 2},
+		{name: "case sensitive", raw: "High", want: -1, wantErr: true},
+		{name: "case insensitive", caseInsensitive: true, raw: "MEDIUM", want: 1},
+		{name: "unknown", raw: "extreme", want: -1, wantErr: true},
+		{name: "number", raw: "1", want: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := -1
+			mv := vtypes.MakeMappedInt(&got, mapping)
+			mv.CaseInsensitive = tt.caseInsensitive
+
+			err := vtypes.Hydrate(&mv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+
+	got := 1
+	mv := vtypes.MakeMappedInt(&got, mapping)
+	if err := mv.Set("extreme"); err == nil || !strings.Contains(err.Error(), "low|medium|high|max") {
+		t.Errorf("Expected error listing tokens, got %v", err)
+	}
+	if text := vtypes.DefaultValueText(&mv); text != "medium" {
+		t.Errorf("Expected default text medium, got %q", text)
+	}
+	got = 2
+	if text := vtypes.DefaultValueText(&mv); text != "high" {
+		t.Errorf("Expected default text high, got %q", text)
+	}
+	got = 7
+	if text := vtypes.DefaultValueText(&mv); text != "7" {
+		t.Errorf("Expected default text 7, got %q", text)
+	}
+	if name := vtypes.ValueTypeName(&mv); name != "enum(low|medium|high|max)" {
+		t.Errorf("Expected type name enum(low|medium|high|max), got %q", name)
+	}
+}
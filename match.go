@@ -0,0 +1,47 @@
+package vtypes
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Match is an implementation of StringSetter that wraps a string value which
+// must match a regular expression. Anchors must be included in the pattern
+// if the entire value is expected to match.
+type Match struct {
+	ptr *string
+
+	Pattern *regexp.Regexp
+}
+
+// MakeMatch returns an instance of Match.
+func MakeMatch(ptr *string, pattern *regexp.Regexp) Match {
+	return Match{
+		ptr:     ptr,
+		Pattern: pattern,
+	}
+}
+
+// Set implements [StringSetter]. The wrapped value is left unchanged if val
+// does not match.
+func (m *Match) Set(val string) error {
+	if !m.Pattern.MatchString(val) {
+		return fmt.Errorf("%w: %q does not match %s", ErrValueUnsupported, val, m.Pattern)
+	}
+	*m.ptr = val
+	return nil
+}
+
+// String implements [fmt.Stringer].
+func (m *Match) String() string {
+	if m.ptr == nil {
+		return ""
+	}
+	return *m.ptr
+}
+
+// ValueTypeName returns the name of the wrapped type along with its pattern
+// (e.g., "match(^[a-z]+$)").
+func (m *Match) ValueTypeName() string {
+	return fmt.Sprintf("match(%s)", m.Pattern)
+}
@@ -0,0 +1,62 @@
+package vtypes_test
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestMatch(t *testing.T) {
+	pattern := regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{name: "simple", raw: "web", want: "web"},
+		{name: "hyphenated", raw: "web-1", want: "web-1"},
+		{name: "uppercase", raw: "Web", want: "default", wantErr: true},
+		{name: "trailing hyphen", raw: "web-", want: "default", wantErr: true},
+		{name: "empty", raw: "", want: "default", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := "default"
+			mv := vtypes.MakeMatch(&got, pattern)
+
+			err := vtypes.Hydrate(&mv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, vtypes.ErrValueUnsupported) {
+					t.Errorf("Expected ErrValueUnsupported in chain, got %v", err)
+				}
+				if !strings.Contains(err.Error(), pattern.String()) {
+					t.Errorf("Expected error to name pattern, got %v", err)
+				}
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMatchText(t *testing.T) {
+	got := "web"
+	mv := vtypes.MakeMatch(&got, regexp.MustCompile(`^[a-z]+$`))
+
+	if name := vtypes.ValueTypeName(&mv); name != "match(^[a-z]+$)" {
+		t.Errorf("Expected type name match(^[a-z]+$), got %q", name)
+	}
+	if text := vtypes.DefaultValueText(&mv); text != "web" {
+		t.Errorf("Expected default text web, got %q", text)
+	}
+}
@@ -0,0 +1,58 @@
+package vtypes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Month is an implementation of TextMarshalUnmarshaler that wraps a time.Month
+// value which is parsed from its full or three letter name (case-insensitive)
+// or from its number (1-12).
+type Month struct {
+	ptr *time.Month
+}
+
+// MakeMonth returns an instance of Month.
+func MakeMonth(ptr *time.Month) Month {
+	return Month{
+		ptr: ptr,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (m *Month) UnmarshalText(text []byte) error {
+	s := string(text)
+	if n, err := strconv.Atoi(s); err == nil {
+		if n < 1 || n > 12 {
+			return fmt.Errorf("%w: month %d not in 1..12", ErrOutOfRange, n)
+		}
+		*m.ptr = time.Month(n)
+		return nil
+	}
+
+	names := make([]string, 0, 12)
+	for mo := time.January; mo <= time.December; mo++ {
+		name := mo.String()
+		if strings.EqualFold(s, name) || strings.EqualFold(s, name[:3]) {
+			*m.ptr = mo
+			return nil
+		}
+		names = append(names, name)
+	}
+	return fmt.Errorf("%w: month %q (valid: %s)", ErrValueUnsupported, s, strings.Join(names, "|"))
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (m *Month) MarshalText() ([]byte, error) {
+	if m.ptr == nil {
+		return nil, nil
+	}
+	return []byte(m.ptr.String()), nil
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (m *Month) ValueTypeName() string {
+	return "month"
+}
@@ -0,0 +1,55 @@
+package vtypes_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/daved/vtypes"
+)
+
+func TestMonth(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    time.Month
+		wantErr bool
+	}{
+		{name: "full", raw: "January", want: time.January},
+		{name: "lower", raw: "september", want: time.September},
+		{name: "abbreviated", raw: "Dec", want: time.December},
+		{name: "abbreviated upper", raw: "FEB", want: time.February},
+		{name: "number", raw: "7", want: time.July},
+		{name: "zero", raw: "0", want: time.March, wantErr: true},
+		{name: "thirteen", raw: "13", want: time.March, wantErr: true},
+		{name: "partial", raw: "Janu", want: time.March, wantErr: true},
+		{name: "empty", raw: "", want: time.March, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := time.March
+			mv := vtypes.MakeMonth(&got)
+
+			err := vtypes.Hydrate(&mv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	got := time.March
+	mv := vtypes.MakeMonth(&got)
+	if err := mv.UnmarshalText([]byte("Smarch")); err == nil || !strings.Contains(err.Error(), "January|February") {
+		t.Errorf("Expected error to list names, got %v", err)
+	}
+	if text := vtypes.DefaultValueText(&mv); text != "March" {
+		t.Errorf("Expected default text March, got %q", text)
+	}
+	if name := vtypes.ValueTypeName(&mv); name != "month" {
+		t.Errorf("Expected type name month, got %q", name)
+	}
+}
@@ -0,0 +1,73 @@
+package vtypes
+
+import "strings"
+
+// NormalizedString is an implementation of StringSetter that wraps a string
+// value which is normalized by Transform when set (e.g., lowercased for
+// case-insensitive identifiers). A nil Transform leaves values as-is.
+type NormalizedString struct {
+	ptr *string
+
+	Transform func(string) string
+}
+
+// MakeNormalizedString returns an instance of NormalizedString.
+func MakeNormalizedString(ptr *string, transform func(string) string) NormalizedString {
+	return NormalizedString{
+		ptr:       ptr,
+		Transform: transform,
+	}
+}
+
+// MakeLowerString returns an instance of NormalizedString which lowercases
+// values.
+func MakeLowerString(ptr *string) NormalizedString {
+	return MakeNormalizedString(ptr, strings.ToLower)
+}
+
+// MakeUpperString returns an instance of NormalizedString which uppercases
+// values.
+func MakeUpperString(ptr *string) NormalizedString {
+	return MakeNormalizedString(ptr, strings.ToUpper)
+}
+
+// MakeTrimmedString returns an instance of NormalizedString which removes
+// leading and trailing whitespace from values.
+func MakeTrimmedString(ptr *string) NormalizedString {
+	return MakeNormalizedString(ptr, strings.TrimSpace)
+}
+
+// Set implements [StringSetter].
+func (n *NormalizedString) Set(val string) error {
+	*n.ptr = n.normalize(val)
+	return nil
+}
+
+// String implements [fmt.Stringer].
+func (n *NormalizedString) String() string {
+	if n.ptr == nil {
+		return ""
+	}
+	return *n.ptr
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (n *NormalizedString) ValueTypeName() string {
+	return "string"
+}
+
+// DefaultValueText returns the normalized form of the current value, which
+// may not have been set through Set.
+func (n *NormalizedString) DefaultValueText() string {
+	if n.ptr == nil {
+		return ""
+	}
+	return n.normalize(*n.ptr)
+}
+
+func (n *NormalizedString) normalize(val string) string {
+	if n.Transform == nil {
+		return val
+	}
+	return n.Transform(val)
+}
@@ -0,0 +1,55 @@
+package vtypes_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestNormalizedString(t *testing.T) {
+	tests := []struct {
+		name        string
+		make        func(*string) vtypes.NormalizedString
+		init        string
+		raw         string
+		want        string
+		wantDefault string
+	}{
+		{name: "lower", make: vtypes.MakeLowerString, init: "Default", raw: "MixedCase", want: "mixedcase", wantDefault: "default"},
+		{name: "upper", make: vtypes.MakeUpperString, init: "us", raw: "eu-West", want: "EU-WEST", wantDefault: "US"},
+		{name: "trimmed", make: vtypes.MakeTrimmedString, init: " x ", raw: "\t value \n", want: "value", wantDefault: "x"},
+		{name: "custom", make: func(p *string) vtypes.NormalizedString {
+			return vtypes.MakeNormalizedString(p, func(s string) string { return strings.ReplaceAll(s, "_", "-") })
+		}, init: "a_b", raw: "snake_case_id", want: "snake-case-id", wantDefault: "a-b"},
+		{name: "nil transform", make: func(p *string) vtypes.NormalizedString {
+			return vtypes.MakeNormalizedString(p, nil)
+		}, init: "As-Is", raw: " Kept ", want: " Kept ", wantDefault: "As-Is"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.init
+			nv := tt.make(&got)
+
+			if text := vtypes.DefaultValueText(&nv); text != tt.wantDefault {
+				t.Errorf("Expected default text %q, got %q", tt.wantDefault, text)
+			}
+			if err := vtypes.Hydrate(&nv, tt.raw); err != nil {
+				t.Fatalf("Hydrate error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+			if text := vtypes.DefaultValueText(&nv); text != tt.want {
+				t.Errorf("Expected default text %q, got %q", tt.want, text)
+			}
+		})
+	}
+
+	var s string
+	nv := vtypes.MakeLowerString(&s)
+	if name := vtypes.ValueTypeName(&nv); name != "string" {
+		t.Errorf("Expected type name string, got %q", name)
+	}
+}
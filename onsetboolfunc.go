@@ -6,8 +6,14 @@ import "strconv"
 type OnSetBoolFunc func(bool) error
 
 // OnSet calls the receiver function, first parsing the string value as a bool
-// type.
+// type. An empty value is treated as true, matching the convention of flags
+// that are provided without a value. Use [MakeBool] for the same handling of
+// *bool values.
 func (f OnSetBoolFunc) OnSet(s string) error {
+	if s == "" {
+		return f(true)
+	}
+
 	b, err := strconv.ParseBool(s)
 	if err != nil {
 		return err
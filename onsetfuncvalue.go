@@ -0,0 +1,42 @@
+package vtypes
+
+// OnSetFuncValue is an implementation of [OnSetter] that wraps an
+// [OnSetFunc] or [OnSetBoolFunc] along with the text to be reported by
+// [DefaultValueText], which is otherwise empty for function types.
+type OnSetFuncValue struct {
+	fn OnSetter
+
+	Text string
+}
+
+// MakeOnSetFunc returns an instance of OnSetFuncValue wrapping fn.
+func MakeOnSetFunc(fn func(string) error, defaultText string) OnSetFuncValue {
+	return OnSetFuncValue{
+		fn:   OnSetFunc(fn),
+		Text: defaultText,
+	}
+}
+
+// MakeOnSetBoolFunc returns an instance of OnSetFuncValue wrapping fn.
+func MakeOnSetBoolFunc(fn func(bool) error, defaultText string) OnSetFuncValue {
+	return OnSetFuncValue{
+		fn:   OnSetBoolFunc(fn),
+		Text: defaultText,
+	}
+}
+
+// OnSet calls the wrapped function.
+func (f *OnSetFuncValue) OnSet(val string) error {
+	return f.fn.OnSet(val)
+}
+
+// IsBool indicates whether the wrapped function is intended to handle bool
+// values.
+func (f *OnSetFuncValue) IsBool() bool {
+	return f.fn.IsBool()
+}
+
+// DefaultValueText returns Text.
+func (f *OnSetFuncValue) DefaultValueText() string {
+	return f.Text
+}
@@ -0,0 +1,66 @@
+package vtypes_test
+
+import (
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestOnSetFuncValue(t *testing.T) {
+	var got string
+	fv := vtypes.MakeOnSetFunc(func(s string) error {
+		got = s
+		return nil
+	}, "none")
+
+	if err := vtypes.Hydrate(&fv, "a"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if got != "a" {
+		t.Errorf("Expected a, got %q", got)
+	}
+	if text := vtypes.DefaultValueText(&fv); text != "none" {
+		t.Errorf("Expected default text none, got %q", text)
+	}
+	if name := vtypes.ValueTypeName(&fv); name != "value" {
+		t.Errorf("Expected type name value, got %q", name)
+	}
+}
+
+func TestOnSetBoolFuncValue(t *testing.T) {
+	var got bool
+	fv := vtypes.MakeOnSetBoolFunc(func(b bool) error {
+		got = b
+		return nil
+	}, "false")
+
+	if err := vtypes.Hydrate(&fv, "true"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if !got {
+		t.Error("Expected true, got false")
+	}
+	if err := vtypes.Hydrate(&fv, "false"); err != nil || got {
+		t.Fatalf("Expected false, got %t (err: %v)", got, err)
+	}
+	if err := vtypes.Hydrate(&fv, ""); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if !got {
+		t.Error("Expected empty value to set true, got false")
+	}
+	if err := vtypes.Hydrate(&fv, "maybe"); err == nil {
+		t.Error("Expected error for invalid bool, got nil")
+	}
+	if text := vtypes.DefaultValueText(&fv); text != "false" {
+		t.Errorf("Expected default text false, got %q", text)
+	}
+	if name := vtypes.ValueTypeName(&fv); name != "bool" {
+		t.Errorf("Expected type name bool, got %q", name)
+	}
+
+	bare := vtypes.OnSetBoolFunc(func(bool) error { return nil })
+	if text := vtypes.DefaultValueText(bare); text != "" {
+		t.Errorf("Expected empty default text for bare func, got %q", text)
+	}
+}
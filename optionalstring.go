@@ -0,0 +1,61 @@
+package vtypes
+
+import "context"
+
+// OptionalString is an implementation of TextMarshalUnmarshaler that wraps a
+// string pointer which is only allocated when a value is provided, so that an
+// empty value can be distinguished from no value. Present is set once any
+// value (including an empty one) has been provided.
+type OptionalString struct {
+	ptr **string
+
+	Present bool
+}
+
+// MakeOptionalString returns an instance of OptionalString.
+func MakeOptionalString(ptr **string) OptionalString {
+	return OptionalString{
+		ptr: ptr,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (o *OptionalString) UnmarshalText(text []byte) error {
+	return o.unmarshalTextContext(context.Background(), text)
+}
+
+// unmarshalTextContext is like UnmarshalText, but hydrates the wrapped value using ctx.
+func (o *OptionalString) unmarshalTextContext(ctx context.Context, text []byte) error {
+	if err := hydrate(ctx, o.ptr, string(text)); err != nil {
+		return err
+	}
+	o.Present = true
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler]. Nil text is returned if
+// no value is held.
+func (o *OptionalString) MarshalText() ([]byte, error) {
+	if o.ptr == nil || *o.ptr == nil {
+		return nil, nil
+	}
+	return []byte(**o.ptr), nil
+}
+
+// IsSet reports whether a value has been provided.
+func (o *OptionalString) IsSet() bool {
+	return o.Present
+}
+
+// Value returns the held value, or an empty string if no value is held.
+func (o *OptionalString) Value() string {
+	if o.ptr == nil || *o.ptr == nil {
+		return ""
+	}
+	return **o.ptr
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (o *OptionalString) ValueTypeName() string {
+	return "string"
+}
@@ -0,0 +1,69 @@
+package vtypes_test
+
+import (
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestOptionalString(t *testing.T) {
+	tests := []struct {
+		name        string
+		raws        []string
+		wantPresent bool
+		want        *string
+	}{
+		{name: "unset", raws: nil, wantPresent: false, want: nil},
+		{name: "empty", raws: []string{""}, wantPresent: true, want: ptr("")},
+		{name: "value", raws: []string{"a"}, wantPresent: true, want: ptr("a")},
+		{name: "last wins", raws: []string{"a", ""}, wantPresent: true, want: ptr("")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *string
+			ov := vtypes.MakeOptionalString(&got)
+
+			for _, raw := range tt.raws {
+				if err := vtypes.Hydrate(&ov, raw); err != nil {
+					t.Fatalf("Hydrate error: %v", err)
+				}
+			}
+
+			if ov.IsSet() != tt.wantPresent || ov.Present != tt.wantPresent {
+				t.Errorf("Expected present %t, got %t", tt.wantPresent, ov.IsSet())
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+
+			var want string
+			if tt.want != nil {
+				want = *tt.want
+			}
+			if v := ov.Value(); v != want {
+				t.Errorf("Expected value %q, got %q", want, v)
+			}
+		})
+	}
+}
+
+func TestOptionalStringText(t *testing.T) {
+	var got *string
+	ov := vtypes.MakeOptionalString(&got)
+
+	if text, err := ov.MarshalText(); err != nil || text != nil {
+		t.Errorf("Expected nil text when unset, got %q (err: %v)", text, err)
+	}
+	if name := vtypes.ValueTypeName(&ov); name != "string" {
+		t.Errorf("Expected type name string, got %q", name)
+	}
+
+	got = ptr("x")
+	if text := vtypes.DefaultValueText(&ov); text != "x" {
+		t.Errorf("Expected default text x, got %q", text)
+	}
+	if ov.IsSet() {
+		t.Error("Expected IsSet to report only provided values")
+	}
+}
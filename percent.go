@@ -0,0 +1,105 @@
+package vtypes
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Percent is an implementation of TextMarshalUnmarshaler that wraps a float64
+// fraction. Text with a trailing "%" is divided by 100 (e.g., "50%" is stored as
+// 0.5), while text without it is stored as-is (e.g., "0.5").
+type Percent struct {
+	ptr *float64
+}
+
+// MakePercent returns an instance of Percent.
+func MakePercent(ptr *float64) Percent {
+	return Percent{
+		ptr: ptr,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (p *Percent) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+	isPercent := strings.HasSuffix(s, "%")
+	num := strings.TrimSuffix(s, "%")
+
+	if !isPercent {
+		f, err := strconv.ParseFloat(num, 64)
+		if err != nil {
+			return err
+		}
+		*p.ptr = f
+		return nil
+	}
+
+	num = strings.TrimSpace(num)
+	if strings.Trim(num, "+-.0123456789") != "" || !strings.ContainsAny(num, "0123456789") {
+		// Exponents, special values, and malformed text are left to ParseFloat.
+		f, err := strconv.ParseFloat(num, 64)
+		if err != nil {
+			return err
+		}
+		*p.ptr = f / 100
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(shiftDecimal(num, -2), 64)
+	if err != nil {
+		return err
+	}
+	*p.ptr = f
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler]. The value is expressed as a
+// percentage (e.g., 0.5 is "50%").
+func (p *Percent) MarshalText() ([]byte, error) {
+	if p.ptr == nil {
+		return nil, nil
+	}
+
+	v := *p.ptr
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return []byte(strconv.FormatFloat(v, 'f', -1, 64) + "%"), nil
+	}
+	return []byte(shiftDecimal(strconv.FormatFloat(v, 'f', -1, 64), 2) + "%"), nil
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (p *Percent) ValueTypeName() string {
+	return "percent"
+}
+
+// shiftDecimal moves the decimal point of the plain decimal number s by n
+// places (right if positive, left if negative) without any loss of precision.
+func shiftDecimal(s string, n int) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, s = s[:1], s[1:]
+	}
+
+	intPart, frac, _ := strings.Cut(s, ".")
+	digits := intPart + frac
+	point := len(intPart) + n
+
+	if point < 0 {
+		digits = strings.Repeat("0", -point) + digits
+		point = 0
+	}
+	if point > len(digits) {
+		digits += strings.Repeat("0", point-len(digits))
+	}
+
+	intPart = strings.TrimLeft(digits[:point], "0")
+	if intPart == "" {
+		intPart = "0"
+	}
+	frac = strings.TrimRight(digits[point:], "0")
+	if frac == "" {
+		return sign + intPart
+	}
+	return sign + intPart + "." + frac
+}
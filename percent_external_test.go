@@ -0,0 +1,83 @@
+package vtypes_test
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		want     float64
+		wantText string
+		wantErr  bool
+	}{
+		{name: "percent", raw: "50%", want: 0.5, wantText: "50%"},
+		{name: "fraction", raw: "0.5", want: 0.5, wantText: "50%"},
+		{name: "small percent", raw: "7%", want: 0.07, wantText: "7%"},
+		{name: "fractional percent", raw: "12.345%", want: 0.12345, wantText: "12.345%"},
+		{name: "leading point", raw: ".5%", want: 0.005, wantText: "0.5%"},
+		{name: "negative", raw: "-150%", want: -1.5, wantText: "-150%"},
+		{name: "exponent", raw: "1e2%", want: 1, wantText: "100%"},
+		{name: "spaced", raw: " 25 % ", want: 0.25, wantText: "25%"},
+		{name: "invalid", raw: "half", wantErr: true},
+		{name: "invalid percent", raw: "x%", wantErr: true},
+		{name: "empty percent", raw: "%", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got float64
+			pv := vtypes.MakePercent(&got)
+
+			err := vtypes.Hydrate(&pv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+			if text := vtypes.DefaultValueText(&pv); text != tt.wantText {
+				t.Errorf("Expected default text %q, got %q", tt.wantText, text)
+			}
+		})
+	}
+}
+
+func TestPercentRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		want := r.NormFloat64()
+		if i%2 == 0 {
+			f, _ := strconv.ParseFloat(strconv.FormatFloat(want, 'f', 3, 64), 64)
+			want = f
+		}
+
+		pv := vtypes.MakePercent(&want)
+		text, err := pv.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText error: %v", err)
+		}
+
+		var got float64
+		other := vtypes.MakePercent(&got)
+		if err := other.UnmarshalText(text); err != nil {
+			t.Fatalf("UnmarshalText(%q) error: %v", text, err)
+		}
+		if got != want {
+			t.Fatalf("Expected %v to round-trip via %q, got %v", want, text, got)
+		}
+	}
+
+	pv := vtypes.MakePercent(new(float64))
+	if name := vtypes.ValueTypeName(&pv); name != "percent" {
+		t.Errorf("Expected type name percent, got %q", name)
+	}
+}
@@ -0,0 +1,52 @@
+package vtypes
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// RangeInt is an implementation of TextMarshalUnmarshaler that wraps an int
+// value which must be within the inclusive range of Min to Max.
+type RangeInt struct {
+	ptr *int
+
+	Min int
+	Max int
+}
+
+// MakeRangeInt returns an instance of RangeInt.
+func MakeRangeInt(ptr *int, min, max int) RangeInt {
+	return RangeInt{
+		ptr: ptr,
+		Min: min,
+		Max: max,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler]. The wrapped value is
+// left unchanged if the parsed value is out of range.
+func (r *RangeInt) UnmarshalText(text []byte) error {
+	n, err := strconv.Atoi(string(text))
+	if err != nil {
+		return err
+	}
+	if n < r.Min || n > r.Max {
+		return fmt.Errorf("%w: %d not in %d..%d", ErrOutOfRange, n, r.Min, r.Max)
+	}
+	*r.ptr = n
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (r *RangeInt) MarshalText() ([]byte, error) {
+	if r.ptr == nil {
+		return nil, nil
+	}
+	return []byte(strconv.Itoa(*r.ptr)), nil
+}
+
+// ValueTypeName returns the name of the wrapped type along with its range
+// (e.g., "int(1..65535)").
+func (r *RangeInt) ValueTypeName() string {
+	return fmt.Sprintf("int(%d..%d)", r.Min, r.Max)
+}
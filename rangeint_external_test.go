@@ -0,0 +1,54 @@
+package vtypes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestRangeInt(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       string
+		want      int
+		wantErr   bool
+		wantErrIs error
+	}{
+		{name: "min", raw: "1", want: 1},
+		{name: "max", raw: "65535", want: 65535},
+		{name: "below", raw: "0", want: 8080, wantErr: true, wantErrIs: vtypes.ErrOutOfRange},
+		{name: "above", raw: "65536", want: 8080, wantErr: true, wantErrIs: vtypes.ErrOutOfRange},
+		{name: "invalid", raw: "port", want: 8080, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := 8080
+			rv := vtypes.MakeRangeInt(&got, 1, 65535)
+
+			err := vtypes.Hydrate(&rv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("Expected %v in chain, got %v", tt.wantErrIs, err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRangeIntText(t *testing.T) {
+	got := 8080
+	rv := vtypes.MakeRangeInt(&got, 1, 65535)
+
+	if name := vtypes.ValueTypeName(&rv); name != "int(1..65535)" {
+		t.Errorf("Expected type name int(1..65535), got %q", name)
+	}
+	if text := vtypes.DefaultValueText(&rv); text != "8080" {
+		t.Errorf("Expected default text 8080, got %q", text)
+	}
+}
@@ -0,0 +1,101 @@
+package vtypes
+
+import (
+	"reflect"
+	"sync"
+)
+
+// HydrateFunc hydrates the value pointed to by ptr using the raw string value.
+type HydrateFunc func(ptr any, raw string) error
+
+var registry = struct {
+	mu  sync.RWMutex
+	fns map[reflect.Type]HydrateFunc
+}{
+	fns: make(map[reflect.Type]HydrateFunc),
+}
+
+// Register sets fn as the handler used by [Hydrate] for values of type typ
+// which are not otherwise supported. The ptr argument received by fn is a
+// pointer to a value of type typ. Registering a nil fn removes the handler.
+// Register is safe for concurrent use.
+func Register(typ reflect.Type, fn HydrateFunc) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if fn == nil {
+		delete(registry.fns, typ)
+		return
+	}
+	registry.fns[typ] = fn
+}
+
+// registered returns the handler registered for typ, if any.
+func registered(typ reflect.Type) (HydrateFunc, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	fn, ok := registry.fns[typ]
+	return fn, ok
+}
+
+// ResetRegistry removes all handlers added by [Register]. It is intended for
+// testing, so that tests which register handlers do not affect one another.
+// ResetRegistry is safe for concurrent use.
+func ResetRegistry() {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.fns = make(map[reflect.Type]HydrateFunc)
+}
+
+// DefaultTextFunc returns the default text of the value pointed to by ptr.
+type DefaultTextFunc func(ptr any) string
+
+var defaultTexts = struct {
+	mu  sync.RWMutex
+	fns map[reflect.Type]DefaultTextFunc
+}{
+	fns: make(map[reflect.Type]DefaultTextFunc),
+}
+
+// SetDefaultTextFunc sets fn as the function used by [DefaultValueText] for
+// values of type typ (e.g., to format all time.Duration defaults
+// consistently). The ptr argument received by fn is a non-nil pointer to a
+// value of type typ. Types implementing [DefaultValueTexter] are not affected.
+// Setting a nil fn removes the function. SetDefaultTextFunc is safe for
+// concurrent use.
+func SetDefaultTextFunc(typ reflect.Type, fn DefaultTextFunc) {
+	defaultTexts.mu.Lock()
+	defer defaultTexts.mu.Unlock()
+
+	if fn == nil {
+		delete(defaultTexts.fns, typ)
+		return
+	}
+	defaultTexts.fns[typ] = fn
+}
+
+// ResetDefaultTextFuncs removes all functions set by [SetDefaultTextFunc]. It
+// is intended for testing. ResetDefaultTextFuncs is safe for concurrent use.
+func ResetDefaultTextFuncs() {
+	defaultTexts.mu.Lock()
+	defer defaultTexts.mu.Unlock()
+
+	defaultTexts.fns = make(map[reflect.Type]DefaultTextFunc)
+}
+
+// defaultTextFunc returns the function set for the type pointed to by val, if
+// any, and if val is a non-nil pointer.
+func defaultTextFunc(val any) (DefaultTextFunc, bool) {
+	rv := reflect.ValueOf(val)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return nil, false
+	}
+
+	defaultTexts.mu.RLock()
+	defer defaultTexts.mu.RUnlock()
+
+	fn, ok := defaultTexts.fns[rv.Type().Elem()]
+	return fn, ok
+}
@@ -0,0 +1,122 @@
+package vtypes_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/daved/vtypes"
+)
+
+type point struct {
+	X, Y string
+}
+
+func hydratePoint(ptr any, raw string) error {
+	x, y, ok := strings.Cut(raw, ":")
+	if !ok {
+		return errors.New("missing colon")
+	}
+	*ptr.(*point) = point{X: x, Y: y}
+	return nil
+}
+
+func TestRegister(t *testing.T) {
+	typ := reflect.TypeOf(point{})
+
+	var p point
+	if err := vtypes.Hydrate(&p, "1:2"); !errors.Is(err, vtypes.ErrTypeUnsupported) {
+		t.Fatalf("Expected ErrTypeUnsupported before registering, got %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			vtypes.Register(typ, hydratePoint)
+		}()
+	}
+	wg.Wait()
+	defer vtypes.Register(typ, nil)
+
+	pp := new(*point) // nil, exercised through a double pointer
+	if err := vtypes.Hydrate(pp, "1:2"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if want := (point{X: "1", Y: "2"}); **pp != want {
+		t.Errorf("Expected %v, got %v", want, **pp)
+	}
+
+	if err := vtypes.Hydrate(&p, "12"); err == nil {
+		t.Errorf("Expected error from registered handler, got nil")
+	}
+}
+
+func TestResetRegistry(t *testing.T) {
+	typ := reflect.TypeOf(point{})
+	vtypes.Register(typ, hydratePoint)
+	t.Cleanup(vtypes.ResetRegistry)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			vtypes.ResetRegistry()
+		}()
+		go func() {
+			defer wg.Done()
+			var p point
+			_ = vtypes.Hydrate(&p, "1:2")
+		}()
+	}
+	wg.Wait()
+
+	var p point
+	if err := vtypes.Hydrate(&p, "1:2"); !errors.Is(err, vtypes.ErrTypeUnsupported) {
+		t.Errorf("Expected ErrTypeUnsupported after reset, got %v", err)
+	}
+}
+
+func TestSetDefaultTextFunc(t *testing.T) {
+	t.Cleanup(vtypes.ResetDefaultTextFuncs)
+
+	typ := reflect.TypeOf(time.Duration(0))
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			vtypes.SetDefaultTextFunc(typ, func(ptr any) string {
+				return fmt.Sprintf("%gs", ptr.(*time.Duration).Seconds())
+			})
+		}()
+	}
+	wg.Wait()
+
+	d := 90 * time.Second
+	if text := vtypes.DefaultValueText(&d); text != "90s" {
+		t.Errorf("Expected 90s, got %q", text)
+	}
+
+	dv := vtypes.MakeDuration(&d, 0)
+	if text := vtypes.DefaultValueText(&dv); text != "1m30s" {
+		t.Errorf("Expected DefaultValueTexter to take precedence, got %q", text)
+	}
+
+	vtypes.SetDefaultTextFunc(typ, nil)
+	if text := vtypes.DefaultValueText(&d); text != "1m30s" {
+		t.Errorf("Expected 1m30s after removal, got %q", text)
+	}
+
+	vtypes.SetDefaultTextFunc(typ, func(any) string { return "x" })
+	vtypes.ResetDefaultTextFuncs()
+	if text := vtypes.DefaultValueText(&d); text != "1m30s" {
+		t.Errorf("Expected 1m30s after reset, got %q", text)
+	}
+}
@@ -0,0 +1,42 @@
+package vtypes
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Rune is an implementation of TextMarshalUnmarshaler that wraps a rune value
+// which is set from and expressed as a single UTF-8 encoded character.
+type Rune struct {
+	ptr *rune
+}
+
+// MakeRune returns an instance of Rune.
+func MakeRune(ptr *rune) Rune {
+	return Rune{
+		ptr: ptr,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (r *Rune) UnmarshalText(text []byte) error {
+	c, size := utf8.DecodeRune(text)
+	if size == 0 || size != len(text) || (c == utf8.RuneError && size == 1) {
+		return fmt.Errorf("%w: %q is not a single character", ErrValueUnsupported, text)
+	}
+	*r.ptr = c
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (r *Rune) MarshalText() ([]byte, error) {
+	if r.ptr == nil {
+		return nil, nil
+	}
+	return []byte(string(*r.ptr)), nil
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (r *Rune) ValueTypeName() string {
+	return "rune"
+}
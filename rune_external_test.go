@@ -0,0 +1,51 @@
+package vtypes_test
+
+import (
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestRune(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    rune
+		wantErr bool
+	}{
+		{name: "ascii", raw: "A", want: 65},
+		{name: "two byte", raw: "é", want: 'é'},
+		{name: "four byte", raw: "🙂", want: '🙂'},
+		{name: "digit", raw: "7", want: '7'},
+		{name: "empty", raw: "", want: 'z', wantErr: true},
+		{name: "multiple", raw: "AB", want: 'z', wantErr: true},
+		{name: "invalid utf8", raw: "\xff", want: 'z', wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := 'z'
+			rv := vtypes.MakeRune(&got)
+
+			err := vtypes.Hydrate(&rv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+			if !tt.wantErr {
+				if text := vtypes.DefaultValueText(&rv); text != tt.raw {
+					t.Errorf("Expected default text %q, got %q", tt.raw, text)
+				}
+			}
+		})
+	}
+}
+
+func TestRuneTypeName(t *testing.T) {
+	rv := vtypes.MakeRune(new(rune))
+	if name := vtypes.ValueTypeName(&rv); name != "rune" {
+		t.Errorf("Expected type name rune, got %q", name)
+	}
+}
@@ -0,0 +1,60 @@
+package vtypes
+
+// secretMask replaces non-empty secret values in text.
+const secretMask = "****"
+
+// Secret is an implementation of TextMarshalUnmarshaler that wraps a string
+// value which is hydrated normally, but which is redacted as "****" (or empty
+// if no value is held) by MarshalText, String, and DefaultValueText so that it
+// is not disclosed by help text, logs, or [Dehydrate]. The value remains
+// available through the wrapped pointer and Value.
+type Secret struct {
+	ptr *string
+}
+
+// MakeSecret returns an instance of Secret.
+func MakeSecret(ptr *string) Secret {
+	return Secret{
+		ptr: ptr,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (s *Secret) UnmarshalText(text []byte) error {
+	*s.ptr = string(text)
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler]. The text is redacted.
+func (s *Secret) MarshalText() ([]byte, error) {
+	if s.ptr == nil {
+		return nil, nil
+	}
+	return []byte(s.String()), nil
+}
+
+// String implements [fmt.Stringer]. The text is redacted.
+func (s *Secret) String() string {
+	if s.ptr == nil || *s.ptr == "" {
+		return ""
+	}
+	return secretMask
+}
+
+// Value returns the held value.
+func (s *Secret) Value() string {
+	if s.ptr == nil {
+		return ""
+	}
+	return *s.ptr
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (s *Secret) ValueTypeName() string {
+	return "string"
+}
+
+// DefaultValueText returns the redacted text.
+func (s *Secret) DefaultValueText() string {
+	return s.String()
+}
@@ -0,0 +1,40 @@
+package vtypes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestSecret(t *testing.T) {
+	var got string
+	sv := vtypes.MakeSecret(&got)
+
+	if text := vtypes.DefaultValueText(&sv); text != "" {
+		t.Errorf("Expected empty text without a value, got %q", text)
+	}
+
+	if err := vtypes.Hydrate(&sv, "hunter2"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if got != "hunter2" || sv.Value() != "hunter2" {
+		t.Errorf("Expected value hunter2, got %q (Value: %q)", got, sv.Value())
+	}
+
+	if text := vtypes.DefaultValueText(&sv); text != "****" {
+		t.Errorf("Expected redacted default text, got %q", text)
+	}
+	if text, _ := sv.MarshalText(); string(text) != "****" {
+		t.Errorf("Expected redacted text, got %q", text)
+	}
+	if text := fmt.Sprint(&sv); text != "****" {
+		t.Errorf("Expected redacted String, got %q", text)
+	}
+	if text, err := vtypes.Dehydrate(&sv); err != nil || text != "****" {
+		t.Errorf("Expected redacted dehydrated text, got %q (err: %v)", text, err)
+	}
+	if name := vtypes.ValueTypeName(&sv); name != "string" {
+		t.Errorf("Expected type name string, got %q", name)
+	}
+}
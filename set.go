@@ -0,0 +1,159 @@
+package vtypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Set is an implementation of TextMarshalUnmarshaler that wraps a map value
+// with empty struct elements (e.g., *map[string]struct{}, possibly with
+// multiple levels of pointers) whose key type is supported by [Hydrate]. Text
+// is split using Separator, and each element is added as a key, so duplicates
+// are ignored. The underlying map is only initialized if values are added;
+// otherwise, nil pointers in the chain remain nil.
+type Set struct {
+	ptrValue any // Stores the original value (e.g., **map[string]struct{})
+	started  bool
+
+	Separator string
+	NonAccum  bool
+}
+
+// MakeSet returns an instance of Set.
+func MakeSet(ptrToMap any) Set {
+	return Set{
+		ptrValue:  ptrToMap,
+		Separator: ",", // Default separator between elements
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (s *Set) UnmarshalText(text []byte) error {
+	return s.unmarshalTextContext(context.Background(), text)
+}
+
+// unmarshalTextContext is like UnmarshalText, but hydrates elements using ctx.
+func (s *Set) unmarshalTextContext(ctx context.Context, text []byte) error {
+	// Preserve nil state if no text
+	if len(text) == 0 {
+		return nil
+	}
+
+	if !isSetType(reflect.TypeOf(s.ptrValue)) {
+		return errors.New("set: contained value is not a pointer to a map with empty struct elements")
+	}
+
+	v := reflect.ValueOf(s.ptrValue)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			// Initialize only if we have values to add
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+
+	// Initialize or reset only if necessary
+	if !s.started || s.NonAccum || v.IsNil() {
+		v.Set(reflect.MakeMap(v.Type()))
+	}
+	s.started = true
+
+	keyType, present := v.Type().Key(), reflect.Zero(v.Type().Elem())
+
+	for _, elem := range strings.Split(string(text), s.Separator) {
+		if len(elem) == 0 {
+			continue // Skip empty elements
+		}
+
+		key := reflect.New(keyType)
+		if err := HydrateContext(ctx, key.Interface(), elem); err != nil {
+			return NewPathError(err, "set", fmt.Sprintf("[%q]", elem))
+		}
+
+		v.SetMapIndex(key.Elem(), present)
+	}
+
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler]. Elements are sorted so that
+// the result is deterministic.
+func (s *Set) MarshalText() ([]byte, error) {
+	v := reflect.ValueOf(s.ptrValue)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, nil // Return nil text for nil pointers
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Map {
+		return nil, errors.New("set: contained value is not a pointer to a map with empty struct elements")
+	}
+
+	keys := sortedMapKeys(v)
+	out := make([]string, len(keys))
+	for i, k := range keys {
+		out[i] = fmt.Sprint(k.Interface())
+	}
+	return []byte(strings.Join(out, s.Separator)), nil
+}
+
+// ValueTypeName returns the name of the wrapped type along with its element
+// type (e.g., "set(string)").
+func (s *Set) ValueTypeName() string {
+	t := reflect.TypeOf(s.ptrValue)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Map {
+		return "set"
+	}
+	return fmt.Sprintf("set(%s)", t.Key())
+}
+
+// Value returns the original value with its pointer chain.
+func (s *Set) Value() any {
+	return s.ptrValue
+}
+
+// isSetType reports whether t is a pointer (possibly with multiple levels) to
+// a map with empty struct elements.
+func isSetType(t reflect.Type) bool {
+	if t == nil || t.Kind() != reflect.Pointer {
+		return false
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Map &&
+		t.Elem().Kind() == reflect.Struct && t.Elem().NumField() == 0
+}
+
+// sortedMapKeys returns the keys of the map v in ascending order. Keys of
+// numeric, string, and bool kinds are compared by value; others are compared
+// by their fmt.Sprint representation.
+func sortedMapKeys(v reflect.Value) []reflect.Value {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		switch a.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return a.Int() < b.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return a.Uint() < b.Uint()
+		case reflect.Float32, reflect.Float64:
+			return a.Float() < b.Float()
+		case reflect.String:
+			return a.String() < b.String()
+		case reflect.Bool:
+			return !a.Bool() && b.Bool()
+		default:
+			return fmt.Sprint(a.Interface()) < fmt.Sprint(b.Interface())
+		}
+	})
+	return keys
+}
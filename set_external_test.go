@@ -0,0 +1,82 @@
+package vtypes_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestSet(t *testing.T) {
+	var set map[string]struct{}
+	sv := vtypes.MakeSet(&set)
+
+	if err := sv.UnmarshalText([]byte("")); err != nil {
+		t.Fatalf("UnmarshalText error: %v", err)
+	}
+	if set != nil {
+		t.Errorf("Expected nil map after empty unmarshal, got %v", set)
+	}
+
+	for _, raw := range []string{"b,a,,b", "c,a"} {
+		if err := vtypes.Hydrate(&sv, raw); err != nil {
+			t.Fatalf("Hydrate error: %v", err)
+		}
+	}
+	want := map[string]struct{}{"a": {}, "b": {}, "c": {}}
+	if !reflect.DeepEqual(set, want) {
+		t.Errorf("Expected %v, got %v", want, set)
+	}
+
+	if text := vtypes.DefaultValueText(&sv); text != "a,b,c" {
+		t.Errorf("Expected default text a,b,c, got %q", text)
+	}
+	if name := vtypes.ValueTypeName(&sv); name != "set(string)" {
+		t.Errorf("Expected type name set(string), got %q", name)
+	}
+}
+
+func TestSetIntsNonAccum(t *testing.T) {
+	var set **map[int]struct{} // nil
+	sv := vtypes.MakeSet(&set)
+	sv.Separator = ";"
+	sv.NonAccum = true
+
+	for _, raw := range []string{"1;2", "10;2;-3;10"} {
+		if err := sv.UnmarshalText([]byte(raw)); err != nil {
+			t.Fatalf("UnmarshalText error: %v", err)
+		}
+	}
+	if want := map[int]struct{}{-3: {}, 2: {}, 10: {}}; set == nil || !reflect.DeepEqual(**set, want) {
+		t.Errorf("Expected %v, got %v", want, set)
+	}
+
+	text, err := sv.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText error: %v", err)
+	}
+	if string(text) != "-3;2;10" {
+		t.Errorf("Expected numerically sorted text -3;2;10, got %q", text)
+	}
+	if name := vtypes.ValueTypeName(&sv); name != "set(int)" {
+		t.Errorf("Expected type name set(int), got %q", name)
+	}
+}
+
+func TestSetErrors(t *testing.T) {
+	set := map[int]struct{}{}
+	sv := vtypes.MakeSet(&set)
+	err := sv.UnmarshalText([]byte("1,x"))
+	if err == nil {
+		t.Fatal("Expected error for invalid element, got nil")
+	}
+	if got := vtypes.ErrorPath(err); got != `["x"]` {
+		t.Errorf("Expected error path [\"x\"], got %q", got)
+	}
+
+	notSet := map[string]int{}
+	nv := vtypes.MakeSet(&notSet)
+	if err := nv.UnmarshalText([]byte("a")); err == nil {
+		t.Error("Expected error for non-set map, got nil")
+	}
+}
@@ -1,11 +1,19 @@
 package vtypes
 
 import (
+	"bufio"
 	"bytes"
+	"context"
+	"encoding/csv"
 	"errors"
 	"fmt"
+	"io"
+	"math"
 	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 // Slice is an implementation of TextMarshalUnmarshaler that wraps a slice value
@@ -13,77 +21,556 @@ import (
 // Behavior can be configured to treat each UnmarshalText call as a set of values.
 // The underlying slice is only initialized if values are added; otherwise, nil
 // pointers in the chain remain nil.
+//
+// Elements of struct types (or pointers to them) that are not otherwise
+// supported by Hydrate are decoded from JSON (see [JSON]). As JSON objects
+// usually contain commas, a different Separator, CSV quoting, or an unset
+// SplitEach is needed to provide more than one per call.
 type Slice struct {
 	ptrValue any // Stores the original value (e.g., **[]int, *[]int, []int)
 	started  bool
 
-	TypeName  string
+	// Cached on first use by resolve.
+	levels    int
+	sliceType reflect.Type
+
+	TypeName string
+
+	// SplitEach causes each UnmarshalText call to be split into a set of values
+	// using Separator. If unset, the text of each call is a single value.
 	SplitEach bool
+
+	// Separator may be any non-empty string (e.g., ", "), unless Escape or CSV
+	// is set, in which case it must be a single rune.
 	Separator string
 	NonAccum  bool
+
+	// SplitPattern, if set, is used to split text when SplitEach is set (e.g.,
+	// `\s+` for runs of whitespace). It takes precedence over Separator,
+	// Escape, and CSV. MarshalText still joins values using Separator.
+	SplitPattern *regexp.Regexp
+
+	// Escape causes a backslash preceding the separator (or another backslash)
+	// to be treated literally.
+	Escape bool
+
+	// CSV causes the text to be parsed as a single CSV record (see
+	// [encoding/csv]) using the first rune of Separator as the delimiter, so
+	// that quoted values may contain the separator. It takes precedence over
+	// Escape.
+	CSV bool
+
+	// Dedup causes values whose text (as produced by MarshalText, before any
+	// escaping) is already held (including values accumulated by prior calls)
+	// to be skipped, preserving the order of first occurrence.
+	Dedup bool
+
+	// TrimSpace causes leading and trailing whitespace to be removed from each
+	// element before it is hydrated. Elements that become empty are skipped.
+	TrimSpace bool
+
+	// ElemFactory, if set, is called to produce a fresh value for each
+	// non-empty element, which is hydrated in place of a new zero value of the
+	// element type. The produced value (or, if it is a pointer, the value it
+	// points to) must be assignable to the element type. This allows concrete
+	// implementations to be provided for interface element types. It does not
+	// affect how NonAccum resets the slice, and is not called for empty chunks.
+	ElemFactory func() any
+
+	// MinLen and MaxLen bound the number of held values, with zero meaning
+	// unbounded. MaxLen is enforced by UnmarshalText, which returns an error
+	// before appending a value over the limit, while both are checked by
+	// ValidateLen once parsing has completed.
+	MinLen int
+	MaxLen int
+
+	// PlaceholderEmpty, if set, is returned by DefaultValueText when no values
+	// are held (e.g., "[]"), so that help text can distinguish an empty default
+	// from no default. MarshalText is not affected.
+	PlaceholderEmpty string
+
+	// MaxDisplay, if positive, limits the number of values included by
+	// DefaultValueText, with any others summarized (e.g., "a,b...(+3 more)").
+	// MarshalText is not affected.
+	MaxDisplay int
+}
+
+// SliceOption configures a Slice.
+type SliceOption func(*Slice)
+
+// WithSeparator sets Slice.Separator.
+func WithSeparator(sep string) SliceOption {
+	return func(s *Slice) { s.Separator = sep }
+}
+
+// WithSeparatorRune sets Slice.Separator to a single rune, as is required by
+// Escape and CSV.
+func WithSeparatorRune(sep rune) SliceOption {
+	return func(s *Slice) { s.Separator = string(sep) }
+}
+
+// WithSplitPattern sets Slice.SplitPattern.
+func WithSplitPattern(re *regexp.Regexp) SliceOption {
+	return func(s *Slice) { s.SplitPattern = re }
+}
+
+// WithSplitEach sets Slice.SplitEach.
+func WithSplitEach(splitEach bool) SliceOption {
+	return func(s *Slice) { s.SplitEach = splitEach }
 }
 
-// MakeSlice returns an instance of Slice.
-func MakeSlice(ptrValue any) Slice {
-	return Slice{
+// WithNonAccum sets Slice.NonAccum.
+func WithNonAccum() SliceOption {
+	return func(s *Slice) { s.NonAccum = true }
+}
+
+// WithDedup sets Slice.Dedup.
+func WithDedup() SliceOption {
+	return func(s *Slice) { s.Dedup = true }
+}
+
+// WithEscape sets Slice.Escape.
+func WithEscape() SliceOption {
+	return func(s *Slice) { s.Escape = true }
+}
+
+// WithCSV sets Slice.CSV.
+func WithCSV() SliceOption {
+	return func(s *Slice) { s.CSV = true }
+}
+
+// WithTrimSpace sets Slice.TrimSpace.
+func WithTrimSpace() SliceOption {
+	return func(s *Slice) { s.TrimSpace = true }
+}
+
+// defaultSeparator is the Separator set by MakeSlice.
+const defaultSeparator = ","
+
+// MakeSlice returns an instance of Slice which, unless configured otherwise by
+// opts, splits each UnmarshalText call on commas.
+func MakeSlice(ptrValue any, opts ...SliceOption) Slice {
+	s := Slice{
 		ptrValue:  ptrValue,
-		Separator: ",", // Default separator for comma-separated lists
+		SplitEach: true,
+		Separator: defaultSeparator,
 	}
+	for _, opt := range opts {
+		opt(&s)
+	}
+	return s
+}
+
+// MakeSliceReplace returns an instance of Slice like MakeSlice, except that
+// NonAccum is set so that each UnmarshalText call replaces any held values.
+func MakeSliceReplace(ptrValue any, opts ...SliceOption) Slice {
+	return MakeSlice(ptrValue, append([]SliceOption{WithNonAccum()}, opts...)...)
+}
+
+// MakeList returns an instance of Slice for plain string lists, which splits
+// each UnmarshalText call on commas and trims surrounding whitespace from each
+// element (e.g., "a, b,,c" results in "a", "b", and "c"). All fields remain
+// configurable.
+func MakeList(ptr *[]string) Slice {
+	return MakeSlice(ptr, WithTrimSpace())
 }
 
 // UnmarshalText implements [encoding.TextUnmarshaler].
 func (s *Slice) UnmarshalText(text []byte) error {
+	return s.unmarshalTextContext(context.Background(), text)
+}
+
+// unmarshalTextContext is like UnmarshalText, but hydrates elements using ctx.
+func (s *Slice) unmarshalTextContext(ctx context.Context, text []byte) error {
 	// Preserve nil state if no text
 	if len(text) == 0 {
 		return nil
 	}
 
-	// Get the value and determine its indirection level
+	chunks, err := s.split(text)
+	if err != nil {
+		return err
+	}
+
+	return s.appendChunks(ctx, chunks)
+}
+
+// Append hydrates and appends each of values as though they were the chunks
+// of a single UnmarshalText call, without splitting or unescaping them. As
+// such, NonAccum causes any held values to be replaced, and Dedup, TrimSpace,
+// ElemFactory, and MaxLen are applied. Like UnmarshalText, the first call
+// that is provided values replaces any values held before the Slice was
+// made, and later calls accumulate unless NonAccum is set. Calling Append
+// without values has no effect.
+func (s *Slice) Append(values ...string) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	return s.appendChunks(context.Background(), values)
+}
+
+// appendChunks hydrates and appends chunks, resetting the slice first if this
+// is the first call or NonAccum is set.
+func (s *Slice) appendChunks(ctx context.Context, chunks []string) error {
+	return s.appendChunksReset(ctx, chunks, !s.started || s.NonAccum)
+}
+
+// appendChunksReset hydrates and appends chunks, resetting the slice first if
+// reset is set.
+func (s *Slice) appendChunksReset(ctx context.Context, chunks []string, reset bool) error {
+	if err := s.resolve(); err != nil {
+		return err
+	}
+
+	// Walk the known number of pointer levels, initializing nil pointers only
+	// now that we have values to add
 	v := reflect.ValueOf(s.ptrValue)
-	pointerLevels := 0
-	for v.Kind() == reflect.Pointer {
-		pointerLevels++
+	for i := 0; i < s.levels; i++ {
 		if v.IsNil() {
-			// Initialize only if we have values to add
 			v.Set(reflect.New(v.Type().Elem()))
 		}
 		v = v.Elem()
 	}
 
-	if v.Kind() != reflect.Slice {
-		return errors.New("slice: contained value is not a slice or pointer to a slice")
-	}
-
 	// Initialize or reset only if necessary
-	if !s.started || s.NonAccum {
-		slice := reflect.MakeSlice(v.Type(), 0, 0)
-		s.setValue(slice)
+	if reset {
+		v.Set(reflect.MakeSlice(s.sliceType, 0, 0))
 	}
 	s.started = true
 
-	valType := v.Type().Elem()
-	sep := s.Separator
-	if !s.SplitEach {
-		// Only use a different separator if explicitly intended; otherwise, keep default
-		sep = s.Separator // Default to "," unless overridden
+	valType := s.sliceType.Elem()
+
+	var seen map[string]struct{}
+	if s.Dedup {
+		seen = make(map[string]struct{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			seen[elemText(v.Index(i))] = struct{}{}
+		}
 	}
 
-	for _, chunk := range bytes.Split(text, []byte(sep)) {
+	for _, chunk := range chunks {
+		if s.TrimSpace {
+			chunk = strings.TrimSpace(chunk)
+		}
 		if len(chunk) == 0 {
 			continue // Skip empty chunks
 		}
-		item := reflect.New(valType)
-		if err := Hydrate(item.Interface(), string(chunk)); err != nil {
-			return fmt.Errorf("slice: unmarshal text: %w", err)
+		item, err := s.hydrateElem(ctx, valType, chunk)
+		if err != nil {
+			return NewPathError(err, "slice", fmt.Sprintf("[%d]", v.Len()))
+		}
+		if seen != nil {
+			key := elemText(item)
+			if _, ok := seen[key]; ok {
+				continue // Skip duplicates
+			}
+			seen[key] = struct{}{}
+		}
+		if s.MaxLen > 0 && v.Len() >= s.MaxLen {
+			return s.lenError(v.Len() + 1) // Values over the limit are not kept
+		}
+		v.Set(reflect.Append(v, item))
+	}
+
+	return nil
+}
+
+// readFromBatch is the number of chunks ReadFrom hydrates at a time.
+const readFromBatch = 64
+
+// ReadFrom implements [io.ReaderFrom]. The content of r is split as by
+// UnmarshalText, except that newlines are used as the separator if SplitEach
+// is unset, and chunks are hydrated incrementally so that the whole of the
+// content is not held in memory. When splitting on newlines (including for
+// [Lines]), a trailing "\r" is removed from each chunk. The content is
+// treated as the text of a single UnmarshalText call (e.g., NonAccum replaces
+// held values once, rather than for each chunk), and empty content has no
+// effect. SplitPattern, Escape, and CSV are not supported. The number of bytes
+// read is returned.
+func (s *Slice) ReadFrom(r io.Reader) (int64, error) {
+	if s.SplitPattern != nil || s.Escape || s.CSV {
+		return 0, fmt.Errorf("%w: slice: ReadFrom does not support SplitPattern, Escape, or CSV", ErrInvalidConfig)
+	}
+
+	sep := s.Separator
+	if !s.SplitEach {
+		sep = "\n"
+	}
+	lines := sep == "\n"
+	if sep == "" {
+		return 0, fmt.Errorf("%w: slice: separator must not be empty", ErrInvalidConfig)
+	}
+
+	cr := &countingReader{r: r}
+	sc := bufio.NewScanner(cr)
+	sc.Buffer(nil, math.MaxInt)
+	sc.Split(splitOn([]byte(sep)))
+
+	reset := !s.started || s.NonAccum
+	batch := make([]string, 0, readFromBatch)
+	flush := func() error {
+		err := s.appendChunksReset(context.Background(), batch, reset)
+		reset, batch = false, batch[:0]
+		return err
+	}
+
+	for sc.Scan() {
+		chunk := sc.Text()
+		if lines {
+			chunk = strings.TrimSuffix(chunk, "\r")
+		}
+		batch = append(batch, chunk)
+		if len(batch) == readFromBatch {
+			if err := flush(); err != nil {
+				return cr.n, err
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return cr.n, err
+	}
+	if len(batch) > 0 {
+		if err := flush(); err != nil {
+			return cr.n, err
+		}
+	}
+
+	return cr.n, nil
+}
+
+// splitOn returns a [bufio.SplitFunc] which splits on sep.
+func splitOn(sep []byte) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		if i := bytes.Index(data, sep); i >= 0 {
+			return i + len(sep), data[:i], nil
+		}
+		if atEOF && len(data) > 0 {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	}
+}
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// hydrateElem returns a new element of type valType hydrated from chunk using
+// ctx. If valType is a pointer type, the element is a non-nil pointer to a
+// newly allocated value.
+func (s *Slice) hydrateElem(ctx context.Context, valType reflect.Type, chunk string) (reflect.Value, error) {
+	if s.ElemFactory == nil {
+		if valType.Kind() == reflect.Pointer {
+			return hydrateNew(ctx, valType.Elem(), chunk)
+		}
+
+		item, err := hydrateNew(ctx, valType, chunk)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return item.Elem(), nil
+	}
+
+	elem := s.ElemFactory()
+	if err := HydrateContext(ctx, elem, chunk); err != nil {
+		return reflect.Value{}, err
+	}
+
+	item := reflect.ValueOf(elem)
+	if item.Type().AssignableTo(valType) {
+		return item, nil
+	}
+	if item.Kind() == reflect.Pointer && item.Type().Elem().AssignableTo(valType) {
+		return item.Elem(), nil
+	}
+	return reflect.Value{}, fmt.Errorf("%w: element factory value %T is not assignable to %v", ErrTypeUnsupported, elem, valType)
+}
+
+// hydrateNew returns a pointer to a new value of type typ hydrated from chunk.
+// Struct types that Hydrate does not support are decoded as JSON.
+func hydrateNew(ctx context.Context, typ reflect.Type, chunk string) (reflect.Value, error) {
+	item := reflect.New(typ)
+	err := HydrateContext(ctx, item.Interface(), chunk)
+	if err != nil && typ.Kind() == reflect.Struct && isUnsupportedType(err) {
+		j := MakeJSON(item.Interface())
+		err = HydrateContext(ctx, &j, chunk)
+	}
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	return item, nil
+}
+
+// isUnsupportedType reports whether err is from Hydrate not supporting the
+// type of the value itself, rather than from a nested value.
+func isUnsupportedType(err error) bool {
+	var herr *HydrateError
+	return errors.As(err, &herr) && herr.child == ErrTypeUnsupported
+}
+
+// split separates text into element chunks according to the configured mode.
+func (s *Slice) split(text []byte) ([]string, error) {
+	if !s.SplitEach {
+		return []string{string(text)}, nil // Treat the whole text as one element
+	}
+	if s.SplitPattern != nil {
+		return s.SplitPattern.Split(string(text), -1), nil
+	}
+	if err := s.checkSeparator(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case s.CSV:
+		r := csv.NewReader(bytes.NewReader(text))
+		r.Comma, _ = utf8.DecodeRuneInString(s.Separator)
+		r.FieldsPerRecord = -1
+		recs, err := r.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("slice: csv: %w", err)
+		}
+		if len(recs) > 1 {
+			return nil, errors.New("slice: csv: text contains multiple records")
+		}
+		if len(recs) == 0 {
+			return nil, nil
+		}
+		return recs[0], nil
+
+	case s.Escape:
+		return splitEscaped(string(text), s.Separator), nil
+
+	default:
+		var chunks []string
+		for _, chunk := range bytes.Split(text, []byte(s.Separator)) {
+			chunks = append(chunks, string(chunk))
 		}
-		slice := reflect.Append(v, item.Elem())
-		s.setValue(slice)
+		return chunks, nil
 	}
+}
 
+// checkSeparator returns an error wrapping ErrInvalidConfig if Separator is
+// not a single rune while Escape or CSV is set.
+func (s *Slice) checkSeparator() error {
+	if (s.Escape || s.CSV) && utf8.RuneCountInString(s.Separator) != 1 {
+		return fmt.Errorf("%w: slice: separator %q must be a single rune when Escape or CSV is set", ErrInvalidConfig, s.Separator)
+	}
 	return nil
 }
 
-// MarshalText implements [encoding.TextMarshaler].
+// Reset returns the Slice to the state it had when made, except that held
+// values are cleared by setting the underlying slice to nil. Nil pointers in
+// the chain are left nil (nothing is allocated), while pointers that are
+// already set remain set. The next UnmarshalText or Append call that is
+// provided values starts from an empty slice even if NonAccum is unset.
+func (s *Slice) Reset() {
+	s.started = false
+
+	v := reflect.ValueOf(s.ptrValue)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Slice && v.CanSet() {
+		v.Set(reflect.Zero(v.Type()))
+	}
+}
+
+// Validate returns an error wrapping ErrInvalidConfig for each contradictory
+// setting, so that configuration mistakes can be caught at setup time rather
+// than when values are parsed. The rules are:
+//   - Escape and CSV require SplitEach, and a Separator of a single rune
+//   - SplitPattern takes precedence over Escape and CSV, so they cannot be
+//     combined
+//   - a Separator other than the default comma requires SplitEach, and
+//     SplitEach requires a non-empty Separator unless SplitPattern is set
+//   - MinLen and MaxLen must not be negative, and MinLen must not exceed
+//     MaxLen when both are set
+func (s *Slice) Validate() error {
+	var errs []error
+	invalid := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%w: slice: "+format, append([]any{ErrInvalidConfig}, args...)...))
+	}
+
+	if (s.Escape || s.CSV) && !s.SplitEach {
+		invalid("Escape and CSV require SplitEach")
+	}
+	if err := s.checkSeparator(); err != nil {
+		errs = append(errs, err)
+	}
+	if s.SplitPattern != nil && (s.Escape || s.CSV) {
+		invalid("SplitPattern cannot be combined with Escape or CSV")
+	}
+	if !s.SplitEach && s.Separator != defaultSeparator {
+		invalid("separator %q requires SplitEach", s.Separator)
+	}
+	if s.SplitEach && s.SplitPattern == nil && s.Separator == "" {
+		invalid("separator must not be empty")
+	}
+	if s.MinLen < 0 || s.MaxLen < 0 {
+		invalid("MinLen (%d) and MaxLen (%d) must not be negative", s.MinLen, s.MaxLen)
+	}
+	if s.MinLen > 0 && s.MaxLen > 0 && s.MinLen > s.MaxLen {
+		invalid("MinLen (%d) exceeds MaxLen (%d)", s.MinLen, s.MaxLen)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateLen reports whether the number of held values is within the bounds
+// set by MinLen and MaxLen. It is intended to be called after all values have
+// been parsed. A nil slice is treated as having a length of zero.
+func (s *Slice) ValidateLen() error {
+	n := 0
+	v := reflect.ValueOf(s.ptrValue)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Slice {
+		n = v.Len()
+	}
+
+	if (s.MinLen > 0 && n < s.MinLen) || (s.MaxLen > 0 && n > s.MaxLen) {
+		return s.lenError(n)
+	}
+	return nil
+}
+
+func (s *Slice) lenError(n int) error {
+	var want string
+	switch {
+	case s.MinLen > 0 && s.MaxLen > 0:
+		want = fmt.Sprintf("%d..%d", s.MinLen, s.MaxLen)
+	case s.MinLen > 0:
+		want = fmt.Sprintf("at least %d", s.MinLen)
+	default:
+		want = fmt.Sprintf("at most %d", s.MaxLen)
+	}
+	return fmt.Errorf("slice: %w: got %d, want %s", ErrSliceLength, n, want)
+}
+
+// MarshalText implements [encoding.TextMarshaler]. Elements are always joined
+// using Separator, so the result can be passed back to a single UnmarshalText
+// call only when SplitEach is set; otherwise, each element must be provided by
+// a separate call. A nil pointer or nil slice results in nil text, while an
+// empty slice results in empty, non-nil text.
+//
+// When SplitEach is set along with Escape or CSV, elements containing the
+// separator are escaped or quoted so that UnmarshalText reproduces the same
+// elements, with the exception of empty elements (which are always skipped).
+// Without Escape or CSV, elements are joined as-is and an element containing
+// the separator will be split apart by UnmarshalText.
 func (s *Slice) MarshalText() ([]byte, error) {
 	v := reflect.ValueOf(s.ptrValue)
 	for v.Kind() == reflect.Pointer {
@@ -95,25 +582,108 @@ func (s *Slice) MarshalText() ([]byte, error) {
 	if v.Kind() != reflect.Slice {
 		return nil, errors.New("slice: contained value is not a slice or pointer to a slice")
 	}
+	if v.IsNil() {
+		return nil, nil // Return nil text for nil slices
+	}
+	if err := s.checkSeparator(); err != nil {
+		return nil, err
+	}
 
 	out := make([]string, v.Len())
 	for i := 0; i < v.Len(); i++ {
-		out[i] = fmt.Sprint(v.Index(i).Interface())
+		out[i] = elemText(v.Index(i))
+		if s.Escape && !s.CSV {
+			out[i] = escapeSeparator(out[i], s.Separator)
+		}
 	}
+
+	if s.CSV {
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		w.Comma, _ = utf8.DecodeRuneInString(s.Separator)
+		if err := w.Write(out); err != nil {
+			return nil, fmt.Errorf("slice: csv: %w", err)
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return nil, fmt.Errorf("slice: csv: %w", err)
+		}
+		return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+	}
+
 	return []byte(strings.Join(out, s.Separator)), nil
 }
 
+// DefaultValueText returns the text of the held values for display (e.g., in
+// help text). It matches MarshalText, except that PlaceholderEmpty (if set) is
+// returned when no values are held, and values beyond MaxDisplay (if set) are
+// summarized.
+func (s *Slice) DefaultValueText() string {
+	v, ok := s.held()
+	if (!ok || v.Len() == 0) && s.PlaceholderEmpty != "" {
+		return s.PlaceholderEmpty
+	}
+
+	shown, more := s, 0
+	if ok && s.MaxDisplay > 0 && v.Len() > s.MaxDisplay {
+		more = v.Len() - s.MaxDisplay
+
+		// Marshal a copy holding only the displayed values
+		p := reflect.New(v.Type())
+		p.Elem().Set(v.Slice(0, s.MaxDisplay))
+		cp := *s
+		cp.ptrValue, cp.sliceType = p.Interface(), nil
+		shown = &cp
+	}
+
+	text, err := shown.MarshalText()
+	if err != nil {
+		return err.Error()
+	}
+	if more > 0 {
+		return fmt.Sprintf("%s...(+%d more)", text, more)
+	}
+	return string(text)
+}
+
+// held returns the held slice, or false if the pointer chain is nil.
+func (s *Slice) held() (reflect.Value, bool) {
+	v := reflect.ValueOf(s.ptrValue)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+	return v, v.Kind() == reflect.Slice
+}
+
 // ValueTypeName returns the name of the underlying slice element type, adding
-// information if unmarshaling is configured to handle a set of values.
+// the separator (e.g., "string(multisep:;)") if unmarshaling is configured to
+// handle a set of values using something other than the default comma.
+// SplitPattern, if set, is reported in place of Separator.
 func (s *Slice) ValueTypeName() string {
-	rv := reflect.ValueOf(s.ptrValue)
-	for rv.Kind() == reflect.Pointer {
-		rv = rv.Elem()
+	t := reflect.TypeOf(s.ptrValue)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	// Pointer elements are reported as such (e.g., "*int")
+	var name string
+	et := t.Elem()
+	for et.Kind() == reflect.Pointer {
+		name += "*"
+		et = et.Elem()
 	}
-	name := rv.Type().Elem().Name()
+	name += et.Name()
 
 	if s.SplitEach {
-		name += fmt.Sprintf("(multisep:%s)", s.Separator)
+		switch {
+		case s.SplitPattern != nil:
+			name += fmt.Sprintf("(multisep:%s)", s.SplitPattern)
+		case s.Separator != defaultSeparator:
+			name += fmt.Sprintf("(multisep:%s)", s.Separator)
+		}
 	}
 
 	return name
@@ -121,11 +691,11 @@ func (s *Slice) ValueTypeName() string {
 
 // IsBool indicates whether the underlying slice element type is bool.
 func (s *Slice) IsBool() bool {
-	rv := reflect.ValueOf(s.ptrValue)
-	for rv.Kind() == reflect.Pointer {
-		rv = rv.Elem()
+	t := reflect.TypeOf(s.ptrValue)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
 	}
-	return rv.Type().Elem().Kind() == reflect.Bool
+	return t.Elem().Kind() == reflect.Bool
 }
 
 // Value returns the original value with its pointer chain.
@@ -133,22 +703,99 @@ func (s *Slice) Value() any {
 	return s.ptrValue
 }
 
-// setValue updates the slice value through the pointer chain.
-func (s *Slice) setValue(slice reflect.Value) {
-	v := reflect.ValueOf(s.ptrValue)
-	for i := 0; i < s.pointerLevels()-1; i++ {
-		v = v.Elem()
+// resolve caches the pointer depth and slice type of ptrValue. Only type
+// information is cached, so pointers in the chain may be set or replaced
+// between calls.
+func (s *Slice) resolve() error {
+	if s.sliceType != nil {
+		return nil
 	}
-	v.Elem().Set(slice)
-}
 
-// pointerLevels returns the number of pointer levels in ptrValue.
-func (s *Slice) pointerLevels() int {
+	t := reflect.TypeOf(s.ptrValue)
 	levels := 0
-	v := reflect.ValueOf(s.ptrValue)
-	for v.Kind() == reflect.Pointer {
+	for t != nil && t.Kind() == reflect.Pointer {
 		levels++
+		t = t.Elem()
+	}
+	if levels == 0 || t.Kind() != reflect.Slice {
+		return errors.New("slice: contained value is not a slice or pointer to a slice")
+	}
+
+	s.levels, s.sliceType = levels, t
+	return nil
+}
+
+// elemText returns the text of a slice element. Pointer elements are followed
+// so that the pointed-to value is used rather than its address, unless the
+// pointer implements fmt.Stringer. Nil pointers result in empty text. Values
+// of numeric and bool kinds which do not implement fmt.Stringer or error are
+// formatted using strconv so that they are parsed back to the same value
+// (e.g., floats use the shortest representation which round-trips).
+func elemText(v reflect.Value) string {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ""
+		}
+		if _, ok := v.Interface().(fmt.Stringer); ok {
+			break
+		}
 		v = v.Elem()
 	}
-	return levels
+
+	switch v.Interface().(type) {
+	case fmt.Stringer, error:
+		return fmt.Sprint(v.Interface())
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits())
+	case reflect.Complex64, reflect.Complex128:
+		return strconv.FormatComplex(v.Complex(), 'g', -1, v.Type().Bits())
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
+
+// splitEscaped splits text on sep. A backslash followed by sep produces a
+// literal sep, and a double backslash produces a single literal backslash. Any
+// other backslash (including a trailing one) is kept as-is.
+func splitEscaped(text, sep string) []string {
+	var out []string
+	var cur strings.Builder
+
+	for i := 0; i < len(text); {
+		switch {
+		case text[i] == '\\' && i+1 < len(text) && text[i+1] == '\\':
+			cur.WriteByte('\\')
+			i += 2
+		case text[i] == '\\' && sep != "" && strings.HasPrefix(text[i+1:], sep):
+			cur.WriteString(sep)
+			i += 1 + len(sep)
+		case sep != "" && strings.HasPrefix(text[i:], sep):
+			out = append(out, cur.String())
+			cur.Reset()
+			i += len(sep)
+		default:
+			cur.WriteByte(text[i])
+			i++
+		}
+	}
+
+	return append(out, cur.String())
+}
+
+// escapeSeparator is the inverse of splitEscaped for a single element.
+func escapeSeparator(elem, sep string) string {
+	elem = strings.ReplaceAll(elem, `\`, `\\`)
+	if sep == "" {
+		return elem
+	}
+	return strings.ReplaceAll(elem, sep, `\`+sep)
 }
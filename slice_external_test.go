@@ -0,0 +1,1277 @@
+package vtypes_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/daved/vtypes"
+)
+
+func TestSliceEscape(t *testing.T) {
+	tests := []struct {
+		name   string
+		raw    string
+		escape bool
+		want   []string
+	}{
+		{name: "disabled", raw: `a\,b,c`, want: []string{`a\`, "b", "c"}},
+		{name: "escaped separator", raw: `a\,b,c`, escape: true, want: []string{"a,b", "c"}},
+		{name: "escaped backslash", raw: `a\\,b`, escape: true, want: []string{`a\`, "b"}},
+		{name: "escaped backslash and separator", raw: `a\\\,b`, escape: true, want: []string{`a\,b`}},
+		{name: "trailing backslash", raw: `a,b\`, escape: true, want: []string{"a", `b\`}},
+		{name: "other escape kept", raw: `a\nb`, escape: true, want: []string{`a\nb`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			sliceVal := vtypes.MakeSlice(&got)
+			sliceVal.Escape = tt.escape
+
+			if err := sliceVal.UnmarshalText([]byte(tt.raw)); err != nil {
+				t.Fatalf("UnmarshalText error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected slice values %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSliceEscapeMarshalText(t *testing.T) {
+	vals := []string{"a,b", `c\`, "d"}
+	sliceVal := vtypes.MakeSlice(&vals)
+	sliceVal.Escape = true
+
+	text, err := sliceVal.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText error: %v", err)
+	}
+	if want := `a\,b,c\\,d`; string(text) != want {
+		t.Errorf("Expected text %q, got %q", want, text)
+	}
+
+	var got []string
+	other := vtypes.MakeSlice(&got)
+	other.Escape = true
+	if err := other.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText error: %v", err)
+	}
+	if !reflect.DeepEqual(got, vals) {
+		t.Errorf("Expected slice values %q, got %q", vals, got)
+	}
+}
+
+func TestSliceDedup(t *testing.T) {
+	var got []int
+	sliceVal := vtypes.MakeSlice(&got)
+	sliceVal.Dedup = true
+
+	for _, raw := range []string{"3,1,3", "2,1", "3,4"} {
+		if err := sliceVal.UnmarshalText([]byte(raw)); err != nil {
+			t.Fatalf("UnmarshalText error: %v", err)
+		}
+	}
+
+	want := []int{3, 1, 2, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected slice values %v, got %v", want, got)
+	}
+}
+
+func TestSliceLen(t *testing.T) {
+	tests := []struct {
+		name       string
+		raws       []string
+		minLen     int
+		maxLen     int
+		wantErr    bool
+		wantLenErr bool
+	}{
+		{name: "unbounded", raws: []string{"1,2,3"}},
+		{name: "within bounds", raws: []string{"1", "2"}, minLen: 2, maxLen: 3},
+		{name: "too many", raws: []string{"1,2", "3,4"}, maxLen: 3, wantErr: true},
+		{name: "too many in one call", raws: []string{"1,2,3"}, maxLen: 2, wantErr: true},
+		{name: "too few", raws: []string{"1"}, minLen: 2, wantLenErr: true},
+		{name: "none", minLen: 1, wantLenErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			sliceVal := vtypes.MakeSlice(&got)
+			sliceVal.MinLen = tt.minLen
+			sliceVal.MaxLen = tt.maxLen
+
+			var err error
+			for _, raw := range tt.raws {
+				if err = sliceVal.UnmarshalText([]byte(raw)); err != nil {
+					break
+				}
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalText error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, vtypes.ErrSliceLength) {
+					t.Errorf("Expected ErrSliceLength, got %v", err)
+				}
+				if len(got) > tt.maxLen {
+					t.Errorf("Expected at most %d held values, got %v", tt.maxLen, got)
+				}
+				return
+			}
+
+			err = sliceVal.ValidateLen()
+			if (err != nil) != tt.wantLenErr {
+				t.Fatalf("ValidateLen error = %v, wantLenErr %v", err, tt.wantLenErr)
+			}
+			if err != nil && !errors.Is(err, vtypes.ErrSliceLength) {
+				t.Errorf("Expected ErrSliceLength, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSliceMarshalTextNilAndEmpty(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   any
+		wantNil bool
+		want    string
+	}{
+		{name: "nil pointer", input: ptr((*[]int)(nil)), wantNil: true},
+		{name: "nil slice", input: new([]int), wantNil: true},
+		{name: "empty slice", input: &[]int{}, want: ""},
+		{name: "values", input: &[]int{1, 2}, want: "1,2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sliceVal := vtypes.MakeSlice(tt.input)
+
+			text, err := sliceVal.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText error: %v", err)
+			}
+			if (text == nil) != tt.wantNil {
+				t.Errorf("Expected nil text %v, got %#v", tt.wantNil, text)
+			}
+			if string(text) != tt.want {
+				t.Errorf("Expected text %q, got %q", tt.want, text)
+			}
+		})
+	}
+}
+
+func TestSliceValueTypeName(t *testing.T) {
+	tests := []struct {
+		name      string
+		splitEach bool
+		sep       string
+		want      string
+	}{
+		{name: "default", splitEach: true, sep: ",", want: "int"},
+		{name: "custom separator", splitEach: true, sep: ";", want: "int(multisep:;)"},
+		{name: "no split", sep: ";", want: "int"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := vtypes.MakeSlice(new([]int))
+			s.SplitEach, s.Separator = tt.splitEach, tt.sep
+			if got := vtypes.ValueTypeName(&s); got != tt.want {
+				t.Errorf("Expected type name %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSliceSplitEach(t *testing.T) {
+	tests := []struct {
+		name      string
+		splitEach bool
+		raws      []string
+		want      []string
+	}{
+		{name: "split", splitEach: true, raws: []string{"a,b,c"}, want: []string{"a", "b", "c"}},
+		{name: "no split", raws: []string{"a,b,c"}, want: []string{"a,b,c"}},
+		{name: "no split accumulated", raws: []string{"a,b", "c"}, want: []string{"a,b", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			sliceVal := vtypes.MakeSlice(&got)
+			sliceVal.SplitEach = tt.splitEach
+
+			for _, raw := range tt.raws {
+				if err := sliceVal.UnmarshalText([]byte(raw)); err != nil {
+					t.Fatalf("UnmarshalText error: %v", err)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected slice values %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSliceCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		sep     string
+		want    []string
+		wantErr bool
+	}{
+		{name: "quoted separator", raw: `"a,b",c`, want: []string{"a,b", "c"}},
+		{name: "escaped quote", raw: `"say ""hi""",x`, want: []string{`say "hi"`, "x"}},
+		{name: "empty fields skipped", raw: `a,,"",b`, want: []string{"a", "b"}},
+		{name: "single field", raw: `a`, want: []string{"a"}},
+		{name: "custom separator", raw: `"a;b";c`, sep: ";", want: []string{"a;b", "c"}},
+		{name: "quoted newline", raw: "\"a\nb\",c", want: []string{"a\nb", "c"}},
+		{name: "bare quote", raw: `a"b,c`, wantErr: true},
+		{name: "multiple records", raw: "a,b\nc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			sliceVal := vtypes.MakeSlice(&got)
+			sliceVal.CSV = true
+			if tt.sep != "" {
+				sliceVal.Separator = tt.sep
+			}
+
+			err := sliceVal.UnmarshalText([]byte(tt.raw))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalText error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected slice values %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSliceCSVMarshalText(t *testing.T) {
+	vals := []string{"a,b", `say "hi"`, "c"}
+	sliceVal := vtypes.MakeSlice(&vals)
+	sliceVal.CSV = true
+
+	text, err := sliceVal.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText error: %v", err)
+	}
+	if want := `"a,b","say ""hi""",c`; string(text) != want {
+		t.Errorf("Expected text %q, got %q", want, text)
+	}
+
+	var got []string
+	other := vtypes.MakeSlice(&got)
+	other.CSV = true
+	if err := other.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText error: %v", err)
+	}
+	if !reflect.DeepEqual(got, vals) {
+		t.Errorf("Expected slice values %q, got %q", vals, got)
+	}
+}
+
+func TestSliceTrimSpace(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       string
+		trimSpace bool
+		want      []string
+	}{
+		{name: "disabled", raw: " a , b ", want: []string{" a ", " b "}},
+		{name: "enabled", raw: " a , b ", trimSpace: true, want: []string{"a", "b"}},
+		{name: "blank chunks skipped", raw: "a, ,\t,b", trimSpace: true, want: []string{"a", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			sliceVal := vtypes.MakeSlice(&got)
+			sliceVal.TrimSpace = tt.trimSpace
+
+			if err := sliceVal.UnmarshalText([]byte(tt.raw)); err != nil {
+				t.Fatalf("UnmarshalText error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected slice values %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	var nums []int
+	sliceVal := vtypes.MakeSlice(&nums)
+	sliceVal.TrimSpace = true
+	if err := sliceVal.UnmarshalText([]byte("1, 2 ,3")); err != nil {
+		t.Fatalf("UnmarshalText error: %v", err)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(nums, want) {
+		t.Errorf("Expected slice values %v, got %v", want, nums)
+	}
+}
+
+type shape interface {
+	Area() int
+}
+
+type square struct {
+	Side int
+}
+
+func (s *square) Area() int { return s.Side * s.Side }
+
+func (s *square) UnmarshalText(text []byte) error {
+	return vtypes.Hydrate(&s.Side, string(text))
+}
+
+func (s *square) MarshalText() ([]byte, error) {
+	return []byte(strconv.Itoa(s.Side)), nil
+}
+
+func TestSliceElemFactory(t *testing.T) {
+	var shapes []shape
+	sliceVal := vtypes.MakeSlice(&shapes)
+
+	if err := sliceVal.UnmarshalText([]byte("2")); err == nil {
+		t.Errorf("Expected error for interface element without factory, got nil")
+	}
+
+	sliceVal.ElemFactory = func() any { return &square{} }
+	if err := sliceVal.UnmarshalText([]byte("2,,3")); err != nil {
+		t.Fatalf("UnmarshalText error: %v", err)
+	}
+	if len(shapes) != 2 || shapes[0].Area() != 4 || shapes[1].Area() != 9 {
+		t.Errorf("Expected areas [4 9], got %v", shapes)
+	}
+
+	var sides []int
+	sideVal := vtypes.MakeSlice(&sides)
+	sideVal.ElemFactory = func() any { return new(int) }
+	if err := sideVal.UnmarshalText([]byte("5,6")); err != nil {
+		t.Fatalf("UnmarshalText error: %v", err)
+	}
+	if want := []int{5, 6}; !reflect.DeepEqual(sides, want) {
+		t.Errorf("Expected slice values %v, got %v", want, sides)
+	}
+
+	sideVal.ElemFactory = func() any { return new(string) }
+	if err := sideVal.UnmarshalText([]byte("7")); !errors.Is(err, vtypes.ErrTypeUnsupported) {
+		t.Errorf("Expected ErrTypeUnsupported for unassignable factory value, got %v", err)
+	}
+}
+
+func TestMakeSliceReplace(t *testing.T) {
+	var got []int
+	sliceVal := vtypes.MakeSliceReplace(&got)
+
+	for _, raw := range []string{"1,2", "3,4"} {
+		if err := sliceVal.UnmarshalText([]byte(raw)); err != nil {
+			t.Fatalf("UnmarshalText error: %v", err)
+		}
+	}
+	if want := []int{3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected slice values %v, got %v", want, got)
+	}
+}
+
+func TestMakeSliceOptions(t *testing.T) {
+	var got []string
+	sliceVal := vtypes.MakeSlice(&got,
+		vtypes.WithSeparator(";"),
+		vtypes.WithNonAccum(),
+		vtypes.WithDedup(),
+		vtypes.WithTrimSpace(),
+	)
+
+	for _, raw := range []string{"x;y", " a ; b ;a"} {
+		if err := sliceVal.UnmarshalText([]byte(raw)); err != nil {
+			t.Fatalf("UnmarshalText error: %v", err)
+		}
+	}
+	if want := []string{"a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected slice values %q, got %q", want, got)
+	}
+
+	whole := vtypes.MakeSlice(&got, vtypes.WithSplitEach(false))
+	if whole.SplitEach || whole.Separator != "," {
+		t.Errorf("Expected SplitEach false with default separator, got %v %q", whole.SplitEach, whole.Separator)
+	}
+
+	replace := vtypes.MakeSliceReplace(&got, vtypes.WithEscape(), vtypes.WithCSV())
+	if !replace.NonAccum || !replace.Escape || !replace.CSV {
+		t.Errorf("Expected NonAccum, Escape, and CSV to be set, got %+v", replace)
+	}
+}
+
+func TestSliceMarshalTextRoundTrip(t *testing.T) {
+	alphabet := []rune{'a', 'b', ',', ';', '\\', '"', ' '}
+	r := rand.New(rand.NewSource(1))
+
+	randElems := func() []string {
+		elems := make([]string, 1+r.Intn(5))
+		for i := range elems {
+			rs := make([]rune, 1+r.Intn(6))
+			for j := range rs {
+				rs[j] = alphabet[r.Intn(len(alphabet))]
+			}
+			elems[i] = string(rs)
+		}
+		return elems
+	}
+
+	modes := []struct {
+		name string
+		opts []vtypes.SliceOption
+	}{
+		{name: "escape", opts: []vtypes.SliceOption{vtypes.WithEscape()}},
+		{name: "escape custom separator", opts: []vtypes.SliceOption{vtypes.WithEscape(), vtypes.WithSeparatorRune('|')}},
+		{name: "csv", opts: []vtypes.SliceOption{vtypes.WithCSV()}},
+		{name: "csv custom separator", opts: []vtypes.SliceOption{vtypes.WithCSV(), vtypes.WithSeparator(";")}},
+	}
+
+	for _, mode := range modes {
+		t.Run(mode.name, func(t *testing.T) {
+			for i := 0; i < 500; i++ {
+				want := randElems()
+				sliceVal := vtypes.MakeSlice(&want, mode.opts...)
+
+				text, err := sliceVal.MarshalText()
+				if err != nil {
+					t.Fatalf("MarshalText error: %v", err)
+				}
+
+				var got []string
+				other := vtypes.MakeSlice(&got, mode.opts...)
+				if err := other.UnmarshalText(text); err != nil {
+					t.Fatalf("UnmarshalText(%q) error: %v", text, err)
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("Expected %q to round-trip via %q, got %q", want, text, got)
+				}
+			}
+		})
+	}
+}
+
+func TestSliceMarshalTextNumericRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	randFloat := func() float64 {
+		for {
+			var f float64
+			switch r.Intn(3) {
+			case 0:
+				f = math.Float64frombits(r.Uint64())
+			case 1:
+				f = r.NormFloat64() * math.Pow(10, float64(r.Intn(40)-20))
+			default:
+				f = float64(r.Intn(2000000)) / 10
+			}
+			if !math.IsNaN(f) {
+				return f
+			}
+		}
+	}
+
+	roundTrip := func(t *testing.T, want any, got any) {
+		t.Helper()
+		sliceVal := vtypes.MakeSlice(want)
+
+		text, err := sliceVal.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText error: %v", err)
+		}
+		if again, _ := sliceVal.MarshalText(); string(again) != string(text) {
+			t.Fatalf("Expected deterministic text %q, got %q", text, again)
+		}
+
+		other := vtypes.MakeSlice(got)
+		if err := other.UnmarshalText(text); err != nil {
+			t.Fatalf("UnmarshalText(%q) error: %v", text, err)
+		}
+		if !reflect.DeepEqual(reflect.ValueOf(got).Elem().Interface(), reflect.ValueOf(want).Elem().Interface()) {
+			t.Fatalf("Expected %v to round-trip via %q, got %v", want, text, got)
+		}
+	}
+
+	t.Run("float64", func(t *testing.T) {
+		for i := 0; i < 500; i++ {
+			want := make([]float64, 1+r.Intn(5))
+			for j := range want {
+				want[j] = randFloat()
+			}
+			var got []float64
+			roundTrip(t, &want, &got)
+		}
+	})
+
+	t.Run("float32", func(t *testing.T) {
+		for i := 0; i < 500; i++ {
+			want := make([]float32, 1+r.Intn(5))
+			for j := range want {
+				want[j] = float32(r.NormFloat64() * math.Pow(10, float64(r.Intn(20)-10)))
+			}
+			var got []float32
+			roundTrip(t, &want, &got)
+		}
+	})
+
+	t.Run("special floats", func(t *testing.T) {
+		want := []float64{0.1, 1e6, 1e21, -0.0, math.MaxFloat64, math.SmallestNonzeroFloat64, math.Inf(1), math.Inf(-1)}
+		var got []float64
+		roundTrip(t, &want, &got)
+	})
+
+	t.Run("large ints", func(t *testing.T) {
+		want := []int64{math.MinInt64, -1, 0, math.MaxInt64}
+		var got []int64
+		roundTrip(t, &want, &got)
+
+		uwant := []uint64{0, math.MaxUint64}
+		var ugot []uint64
+		roundTrip(t, &uwant, &ugot)
+	})
+}
+
+func BenchmarkSliceUnmarshalText(b *testing.B) {
+	nums := make([]string, 64)
+	for i := range nums {
+		nums[i] = strconv.Itoa(i)
+	}
+	text := []byte(strings.Join(nums, ","))
+
+	b.Run("single pointer", func(b *testing.B) {
+		var vals []int
+		s := vtypes.MakeSliceReplace(&vals)
+		for i := 0; i < b.N; i++ {
+			if err := s.UnmarshalText(text); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("triple pointer", func(b *testing.B) {
+		var vals **[]int
+		s := vtypes.MakeSliceReplace(&vals)
+		for i := 0; i < b.N; i++ {
+			if err := s.UnmarshalText(text); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestSlicePointerChainReplacedBetweenCalls(t *testing.T) {
+	var vals *[]int // nil
+	s := vtypes.MakeSlice(&vals)
+
+	if err := s.UnmarshalText([]byte("1,2")); err != nil {
+		t.Fatalf("UnmarshalText error: %v", err)
+	}
+	first := vals
+
+	vals = nil // dropped by the caller; must be reinitialized
+	if err := s.UnmarshalText([]byte("3")); err != nil {
+		t.Fatalf("UnmarshalText error: %v", err)
+	}
+	if vals == nil || !reflect.DeepEqual(*vals, []int{3}) {
+		t.Errorf("Expected [3], got %v", vals)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(*first, want) {
+		t.Errorf("Expected prior slice to remain %v, got %v", want, *first)
+	}
+
+	var notSlice int
+	ns := vtypes.MakeSlice(&notSlice)
+	if err := ns.UnmarshalText([]byte("1")); err == nil {
+		t.Error("Expected error for non-slice value, got nil")
+	}
+}
+
+func TestSlicePointerElements(t *testing.T) {
+	t.Run("ints", func(t *testing.T) {
+		var vals *[]*int // nil
+		s := vtypes.MakeSlice(&vals)
+
+		if err := s.UnmarshalText([]byte("1,,2")); err != nil {
+			t.Fatalf("UnmarshalText error: %v", err)
+		}
+		if vals == nil || len(*vals) != 2 {
+			t.Fatalf("Expected 2 elements, got %v", vals)
+		}
+		for i, want := range []int{1, 2} {
+			if p := (*vals)[i]; p == nil || *p != want {
+				t.Errorf("Expected element %d to point to %d, got %v", i, want, p)
+			}
+		}
+		if (*vals)[0] == (*vals)[1] {
+			t.Error("Expected distinct pointers")
+		}
+
+		text, err := s.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText error: %v", err)
+		}
+		if string(text) != "1,2" {
+			t.Errorf("Expected text 1,2, got %q", text)
+		}
+
+		if err := s.UnmarshalText([]byte("x")); err == nil {
+			t.Error("Expected error for invalid element, got nil")
+		}
+	})
+
+	t.Run("strings dedup", func(t *testing.T) {
+		var vals []*string
+		s := vtypes.MakeSlice(&vals, vtypes.WithDedup())
+
+		for _, raw := range []string{"a,,b,a", "b,c"} {
+			if err := s.UnmarshalText([]byte(raw)); err != nil {
+				t.Fatalf("UnmarshalText error: %v", err)
+			}
+		}
+
+		got := make([]string, len(vals))
+		for i, p := range vals {
+			got[i] = *p
+		}
+		if want := []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %q, got %q", want, got)
+		}
+		if text := vtypes.DefaultValueText(&s); text != "a,b,c" {
+			t.Errorf("Expected default text a,b,c, got %q", text)
+		}
+	})
+
+	t.Run("nil element", func(t *testing.T) {
+		vals := []*int{nil, ptr(3)}
+		s := vtypes.MakeSlice(&vals)
+
+		text, err := s.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText error: %v", err)
+		}
+		if string(text) != ",3" {
+			t.Errorf("Expected text ,3, got %q", text)
+		}
+	})
+}
+
+func TestSliceAppend(t *testing.T) {
+	t.Run("accumulate", func(t *testing.T) {
+		vals := []string{"held"}
+		s := vtypes.MakeSlice(&vals, vtypes.WithDedup())
+
+		if err := s.Append(); err != nil {
+			t.Fatalf("Append error: %v", err)
+		}
+		if want := []string{"held"}; !reflect.DeepEqual(vals, want) {
+			t.Errorf("Expected %q after empty Append, got %q", want, vals)
+		}
+
+		if err := s.Append("a,b", "", "c"); err != nil {
+			t.Fatalf("Append error: %v", err)
+		}
+		if err := s.UnmarshalText([]byte("c,d")); err != nil {
+			t.Fatalf("UnmarshalText error: %v", err)
+		}
+		if err := s.Append("a,b", "e"); err != nil {
+			t.Fatalf("Append error: %v", err)
+		}
+		if want := []string{"a,b", "c", "d", "e"}; !reflect.DeepEqual(vals, want) {
+			t.Errorf("Expected %q, got %q", want, vals)
+		}
+	})
+
+	t.Run("non-accum", func(t *testing.T) {
+		var vals []int
+		s := vtypes.MakeSliceReplace(&vals)
+
+		for _, values := range [][]string{{"1", "2"}, {"3"}} {
+			if err := s.Append(values...); err != nil {
+				t.Fatalf("Append error: %v", err)
+			}
+		}
+		if want := []int{3}; !reflect.DeepEqual(vals, want) {
+			t.Errorf("Expected %v, got %v", want, vals)
+		}
+	})
+
+	t.Run("error path", func(t *testing.T) {
+		var vals []int
+		s := vtypes.MakeSlice(&vals)
+
+		err := s.Append("1", "x")
+		if got := vtypes.ErrorPath(err); got != "[1]" {
+			t.Errorf("Expected error path [1], got %q (err: %v)", got, err)
+		}
+	})
+}
+
+func TestSliceReset(t *testing.T) {
+	vals := []int{7}
+	s := vtypes.MakeSlice(&vals)
+
+	for _, raw := range []string{"1,2", "3"} {
+		if err := s.UnmarshalText([]byte(raw)); err != nil {
+			t.Fatalf("UnmarshalText error: %v", err)
+		}
+	}
+
+	s.Reset()
+	if vals != nil {
+		t.Errorf("Expected nil slice after Reset, got %v", vals)
+	}
+	if text, _ := s.MarshalText(); text != nil {
+		t.Errorf("Expected nil text after Reset, got %q", text)
+	}
+
+	for _, raw := range []string{"4", "5"} {
+		if err := s.UnmarshalText([]byte(raw)); err != nil {
+			t.Fatalf("UnmarshalText error: %v", err)
+		}
+	}
+	if want := []int{4, 5}; !reflect.DeepEqual(vals, want) {
+		t.Errorf("Expected %v, got %v", want, vals)
+	}
+
+	var nilChain **[]int
+	ns := vtypes.MakeSlice(&nilChain)
+	ns.Reset()
+	if nilChain != nil {
+		t.Errorf("Expected nil pointer chain to remain nil, got %v", nilChain)
+	}
+	if err := ns.UnmarshalText([]byte("1")); err != nil {
+		t.Fatalf("UnmarshalText error: %v", err)
+	}
+	ns.Reset()
+	if nilChain == nil || *nilChain == nil || **nilChain != nil {
+		t.Errorf("Expected set pointers to remain with a nil slice, got %v", nilChain)
+	}
+}
+
+func TestMakeList(t *testing.T) {
+	var vals []string
+	s := vtypes.MakeList(&vals)
+
+	for _, raw := range []string{"a, b,,  c ", " ,b"} {
+		if err := vtypes.Hydrate(&s, raw); err != nil {
+			t.Fatalf("Hydrate error: %v", err)
+		}
+	}
+	if want := []string{"a", "b", "c", "b"}; !reflect.DeepEqual(vals, want) {
+		t.Errorf("Expected %q, got %q", want, vals)
+	}
+	if text := vtypes.DefaultValueText(&s); text != "a,b,c,b" {
+		t.Errorf("Expected default text a,b,c,b, got %q", text)
+	}
+
+	s.Dedup = true
+	s.NonAccum = true
+	if err := vtypes.Hydrate(&s, "x; y, x"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if want := []string{"x; y", "x"}; !reflect.DeepEqual(vals, want) {
+		t.Errorf("Expected %q, got %q", want, vals)
+	}
+}
+
+func TestSlicePlaceholderEmpty(t *testing.T) {
+	tests := []struct {
+		name string
+		vals *[]string
+		want string
+	}{
+		{name: "nil pointer", vals: nil, want: "[]"},
+		{name: "nil slice", vals: new([]string), want: "[]"},
+		{name: "empty slice", vals: &[]string{}, want: "[]"},
+		{name: "empty element", vals: &[]string{""}, want: ""},
+		{name: "values", vals: &[]string{"a", "b"}, want: "a,b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vals := tt.vals
+			s := vtypes.MakeSlice(&vals)
+			s.PlaceholderEmpty = "[]"
+
+			if text := vtypes.DefaultValueText(&s); text != tt.want {
+				t.Errorf("Expected default text %q, got %q", tt.want, text)
+			}
+			if text, _ := s.MarshalText(); string(text) == "[]" {
+				t.Error("Expected MarshalText not to use the placeholder")
+			}
+
+			s.PlaceholderEmpty = ""
+			if text, _ := s.MarshalText(); vtypes.DefaultValueText(&s) != string(text) {
+				t.Errorf("Expected default text to match MarshalText without a placeholder")
+			}
+		})
+	}
+}
+
+func TestSliceSeparatorRunes(t *testing.T) {
+	t.Run("plain multi-rune", func(t *testing.T) {
+		var vals []string
+		s := vtypes.MakeSlice(&vals, vtypes.WithSeparator(", "))
+
+		if err := s.UnmarshalText([]byte("a, b,c, d")); err != nil {
+			t.Fatalf("UnmarshalText error: %v", err)
+		}
+		if want := []string{"a", "b,c", "d"}; !reflect.DeepEqual(vals, want) {
+			t.Errorf("Expected %q, got %q", want, vals)
+		}
+		if text, err := s.MarshalText(); err != nil || string(text) != "a, b,c, d" {
+			t.Errorf("Expected text %q, got %q (err: %v)", "a, b,c, d", text, err)
+		}
+	})
+
+	modes := []struct {
+		name string
+		opts []vtypes.SliceOption
+	}{
+		{name: "escape", opts: []vtypes.SliceOption{vtypes.WithEscape()}},
+		{name: "csv", opts: []vtypes.SliceOption{vtypes.WithCSV()}},
+	}
+	seps := []struct {
+		name    string
+		sep     string
+		wantErr bool
+	}{
+		{name: "multi-rune", sep: ", ", wantErr: true},
+		{name: "empty", sep: "", wantErr: true},
+		{name: "multi-byte rune", sep: "·"},
+	}
+
+	for _, mode := range modes {
+		for _, tt := range seps {
+			t.Run(mode.name+" "+tt.name, func(t *testing.T) {
+				vals := []string{"x"}
+				s := vtypes.MakeSlice(&vals, append(mode.opts, vtypes.WithSeparator(tt.sep))...)
+
+				err := s.UnmarshalText([]byte("a·b"))
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if tt.wantErr {
+					if !errors.Is(err, vtypes.ErrInvalidConfig) {
+						t.Errorf("Expected ErrInvalidConfig in chain, got %v", err)
+					}
+					if want := []string{"x"}; !reflect.DeepEqual(vals, want) {
+						t.Errorf("Expected values to be untouched, got %q", vals)
+					}
+					if _, err := s.MarshalText(); !errors.Is(err, vtypes.ErrInvalidConfig) {
+						t.Errorf("Expected ErrInvalidConfig from MarshalText, got %v", err)
+					}
+					return
+				}
+				if want := []string{"a", "b"}; !reflect.DeepEqual(vals, want) {
+					t.Errorf("Expected %q, got %q", want, vals)
+				}
+			})
+		}
+	}
+
+	t.Run("single value ignores separator", func(t *testing.T) {
+		var vals []string
+		s := vtypes.MakeSlice(&vals, vtypes.WithEscape(), vtypes.WithSeparator(", "), vtypes.WithSplitEach(false))
+		if err := s.UnmarshalText([]byte("a, b")); err != nil {
+			t.Fatalf("UnmarshalText error: %v", err)
+		}
+	})
+}
+
+func TestSliceStructElements(t *testing.T) {
+	a, b := endpoint{Host: "a", Port: 1}, endpoint{Host: "b", Port: 2}
+
+	t.Run("separator", func(t *testing.T) {
+		var vals []endpoint
+		s := vtypes.MakeSlice(&vals, vtypes.WithSeparator("|"))
+
+		if err := s.UnmarshalText([]byte(`{"host":"a","port":1}|{"host":"b","port":2}`)); err != nil {
+			t.Fatalf("UnmarshalText error: %v", err)
+		}
+		if want := []endpoint{a, b}; !reflect.DeepEqual(vals, want) {
+			t.Errorf("Expected %v, got %v", want, vals)
+		}
+	})
+
+	t.Run("pointer elements per call", func(t *testing.T) {
+		var vals []*endpoint
+		s := vtypes.MakeSlice(&vals, vtypes.WithSplitEach(false))
+
+		for _, raw := range []string{`{"host":"a","port":1}`, `{"host":"b","port":2}`} {
+			if err := vtypes.Hydrate(&s, raw); err != nil {
+				t.Fatalf("Hydrate error: %v", err)
+			}
+		}
+		if len(vals) != 2 || *vals[0] != a || *vals[1] != b {
+			t.Errorf("Expected [%v %v], got %v", a, b, vals)
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		var vals []endpoint
+		s := vtypes.MakeSlice(&vals, vtypes.WithCSV())
+
+		if err := s.UnmarshalText([]byte(`"{""host"":""a"",""port"":1}",{}`)); err != nil {
+			t.Fatalf("UnmarshalText error: %v", err)
+		}
+		if want := []endpoint{a, {}}; !reflect.DeepEqual(vals, want) {
+			t.Errorf("Expected %v, got %v", want, vals)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var vals []endpoint
+		s := vtypes.MakeSlice(&vals, vtypes.WithSeparator("|"))
+
+		err := s.UnmarshalText([]byte(`{"host":"a"}|{"port":"x"}`))
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+		if got := vtypes.ErrorPath(err); got != "[1]" {
+			t.Errorf("Expected error path [1], got %q", got)
+		}
+	})
+
+	t.Run("supported structs unaffected", func(t *testing.T) {
+		var vals []time.Time
+		s := vtypes.MakeSlice(&vals)
+
+		if err := s.UnmarshalText([]byte(`"2024-01-02T03:04:05Z"`)); err == nil {
+			t.Error("Expected time.Time elements not to be decoded as JSON")
+		}
+	})
+}
+
+func TestSliceValueTypeNamePointerElements(t *testing.T) {
+	tests := []struct {
+		name string
+		val  any
+		want string
+	}{
+		{name: "strings", val: new([]string), want: "string"},
+		{name: "pointer strings", val: new([]*string), want: "*string"},
+		{name: "nil pointer to pointer strings", val: new(*[]*string), want: "*string"},
+		{name: "double pointer ints", val: new([]**int), want: "**int"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := vtypes.MakeSlice(tt.val, vtypes.WithSplitEach(false))
+			if got := s.ValueTypeName(); got != tt.want {
+				t.Errorf("Expected type name %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	var bools *[]*bool // nil
+	bs := vtypes.MakeSlice(&bools)
+	if bs.IsBool() {
+		t.Error("Expected IsBool to be false for pointer elements")
+	}
+}
+
+func TestSliceMaxDisplay(t *testing.T) {
+	tests := []struct {
+		name       string
+		vals       []int
+		maxDisplay int
+		want       string
+	}{
+		{name: "unlimited", vals: []int{1, 2, 3, 4}, want: "1,2,3,4"},
+		{name: "under", vals: []int{1, 2}, maxDisplay: 3, want: "1,2"},
+		{name: "at", vals: []int{1, 2, 3}, maxDisplay: 3, want: "1,2,3"},
+		{name: "over", vals: []int{1, 2, 3, 4, 5}, maxDisplay: 2, want: "1,2...(+3 more)"},
+		{name: "one", vals: []int{1, 2}, maxDisplay: 1, want: "1...(+1 more)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vals := tt.vals
+			s := vtypes.MakeSlice(&vals)
+			s.MaxDisplay = tt.maxDisplay
+
+			if text := vtypes.DefaultValueText(&s); text != tt.want {
+				t.Errorf("Expected default text %q, got %q", tt.want, text)
+			}
+
+			text, err := s.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText error: %v", err)
+			}
+			var all []string
+			for _, v := range tt.vals {
+				all = append(all, strconv.Itoa(v))
+			}
+			if want := strings.Join(all, ","); string(text) != want {
+				t.Errorf("Expected complete text %q, got %q", want, text)
+			}
+		})
+	}
+
+	vals := []string{"a,b", "c", "d"}
+	s := vtypes.MakeSlice(&vals, vtypes.WithCSV())
+	s.MaxDisplay = 1
+	if text := vtypes.DefaultValueText(&s); text != `"a,b"...(+2 more)` {
+		t.Errorf("Expected quoted default text, got %q", text)
+	}
+}
+
+func TestSliceReadFrom(t *testing.T) {
+	t.Run("large", func(t *testing.T) {
+		vals := []int{-1}
+		s := vtypes.MakeSlice(&vals)
+
+		var want []int
+		var raws []string
+		for i := 0; i < 200; i++ {
+			want = append(want, i)
+			raws = append(raws, strconv.Itoa(i))
+		}
+		raw := strings.Join(raws, ",")
+
+		n, err := s.ReadFrom(iotest.OneByteReader(strings.NewReader(raw)))
+		if err != nil {
+			t.Fatalf("ReadFrom error: %v", err)
+		}
+		if n != int64(len(raw)) {
+			t.Errorf("Expected %d bytes read, got %d", len(raw), n)
+		}
+		if !reflect.DeepEqual(vals, want) {
+			t.Errorf("Expected %d values from 0 to 199, got %v", len(want), vals)
+		}
+	})
+
+	t.Run("accumulate and dedup", func(t *testing.T) {
+		var vals []string
+		s := vtypes.MakeSlice(&vals, vtypes.WithSeparator("; "), vtypes.WithDedup())
+
+		if err := s.UnmarshalText([]byte("a; b")); err != nil {
+			t.Fatalf("UnmarshalText error: %v", err)
+		}
+		if _, err := s.ReadFrom(strings.NewReader("b; c; ; a; d")); err != nil {
+			t.Fatalf("ReadFrom error: %v", err)
+		}
+		if want := []string{"a", "b", "c", "d"}; !reflect.DeepEqual(vals, want) {
+			t.Errorf("Expected %q, got %q", want, vals)
+		}
+	})
+
+	t.Run("non-accum replaces once", func(t *testing.T) {
+		vals := []int{9}
+		s := vtypes.MakeSliceReplace(&vals)
+
+		var raws []string
+		for i := 0; i < 100; i++ {
+			raws = append(raws, "1")
+		}
+		if _, err := s.ReadFrom(strings.NewReader(strings.Join(raws, ","))); err != nil {
+			t.Fatalf("ReadFrom error: %v", err)
+		}
+		if len(vals) != 100 {
+			t.Errorf("Expected 100 values, got %d", len(vals))
+		}
+	})
+
+	t.Run("lines", func(t *testing.T) {
+		var vals []string
+		s := vtypes.MakeSlice(&vals, vtypes.WithSplitEach(false))
+
+		if _, err := s.ReadFrom(strings.NewReader("a,b\r\n\nc\n")); err != nil {
+			t.Fatalf("ReadFrom error: %v", err)
+		}
+		if want := []string{"a,b", "c"}; !reflect.DeepEqual(vals, want) {
+			t.Errorf("Expected %q, got %q", want, vals)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		var vals *[]int
+		s := vtypes.MakeSlice(&vals)
+
+		n, err := s.ReadFrom(strings.NewReader(""))
+		if err != nil || n != 0 || vals != nil {
+			t.Errorf("Expected no effect, got %v (n: %d, err: %v)", vals, n, err)
+		}
+	})
+
+	t.Run("error path", func(t *testing.T) {
+		var vals []int
+		s := vtypes.MakeSlice(&vals)
+
+		raw := strings.Repeat("1,", 70) + "x"
+		_, err := s.ReadFrom(strings.NewReader(raw))
+		if got := vtypes.ErrorPath(err); got != "[70]" {
+			t.Errorf("Expected error path [70], got %q (err: %v)", got, err)
+		}
+	})
+
+	t.Run("csv unsupported", func(t *testing.T) {
+		var vals []string
+		s := vtypes.MakeSlice(&vals, vtypes.WithCSV())
+
+		if _, err := s.ReadFrom(strings.NewReader("a")); !errors.Is(err, vtypes.ErrInvalidConfig) {
+			t.Errorf("Expected ErrInvalidConfig, got %v", err)
+		}
+	})
+}
+
+func TestSliceSplitPattern(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []vtypes.SliceOption
+		raw  string
+		want []string
+	}{
+		{name: "whitespace", raw: "a  b\tc", want: []string{"a", "b", "c"}},
+		{name: "mixed", raw: "a,b;c", opts: []vtypes.SliceOption{vtypes.WithSplitPattern(regexp.MustCompile(`[,;]`))}, want: []string{"a", "b", "c"}},
+		{name: "over csv", raw: `"a b" c`, opts: []vtypes.SliceOption{vtypes.WithCSV(), vtypes.WithSeparator("ab")}, want: []string{`"a`, `b"`, "c"}},
+		{name: "no split", raw: "a  b", opts: []vtypes.SliceOption{vtypes.WithSplitEach(false)}, want: []string{"a  b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			opts := append([]vtypes.SliceOption{vtypes.WithSplitPattern(regexp.MustCompile(`\s+`))}, tt.opts...)
+			s := vtypes.MakeSlice(&got, opts...)
+
+			if err := s.UnmarshalText([]byte(tt.raw)); err != nil {
+				t.Fatalf("UnmarshalText error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	var got []int
+	s := vtypes.MakeSlice(&got, vtypes.WithSplitPattern(regexp.MustCompile(`\s+`)))
+	if err := s.UnmarshalText([]byte("1 2\n3")); err != nil {
+		t.Fatalf("UnmarshalText error: %v", err)
+	}
+	if text, _ := s.MarshalText(); string(text) != "1,2,3" {
+		t.Errorf("Expected MarshalText to use Separator, got %q", text)
+	}
+}
+
+func TestSliceValueTypeNameSeparator(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []vtypes.SliceOption
+		want string
+	}{
+		{name: "default separator", want: "int"},
+		{name: "custom separator", opts: []vtypes.SliceOption{vtypes.WithSeparator(";")}, want: "int(multisep:;)"},
+		{name: "custom separator no split", opts: []vtypes.SliceOption{vtypes.WithSeparator(";"), vtypes.WithSplitEach(false)}, want: "int"},
+		{name: "split pattern", opts: []vtypes.SliceOption{vtypes.WithSplitPattern(regexp.MustCompile(`\s+`))}, want: `int(multisep:\s+)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := vtypes.MakeSlice(new([]int), tt.opts...)
+			if got := vtypes.ValueTypeName(&s); got != tt.want {
+				t.Errorf("Expected type name %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSliceDurations(t *testing.T) {
+	var got []time.Duration
+	s, ok := vtypes.ConvertCompatible(&got).(*vtypes.Slice)
+	if !ok {
+		t.Fatalf("Expected *vtypes.Slice from ConvertCompatible")
+	}
+
+	if err := vtypes.Hydrate(s, "1s,2m,3h"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	want := []time.Duration{time.Second, 2 * time.Minute, 3 * time.Hour}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+
+	text, err := s.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText error: %v", err)
+	}
+	if string(text) != "1s,2m0s,3h0m0s" {
+		t.Errorf("Expected text from String per element, got %q", text)
+	}
+
+	var again []time.Duration
+	rs := vtypes.MakeSlice(&again)
+	if err := rs.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText error: %v", err)
+	}
+	if !reflect.DeepEqual(again, want) {
+		t.Errorf("Expected round trip to %v, got %v", want, again)
+	}
+
+	if err := vtypes.Hydrate(s, "1x"); err == nil || vtypes.ErrorPath(err) != "[3]" {
+		t.Errorf("Expected error at [3], got %v", err)
+	}
+}
+
+func TestSliceValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []vtypes.SliceOption
+		setup   func(*vtypes.Slice)
+		wantErr bool
+	}{
+		{name: "default"},
+		{name: "no split", opts: []vtypes.SliceOption{vtypes.WithSplitEach(false)}},
+		{name: "escape", opts: []vtypes.SliceOption{vtypes.WithEscape(), vtypes.WithSeparatorRune('|')}},
+		{name: "csv", opts: []vtypes.SliceOption{vtypes.WithCSV()}},
+		{name: "pattern", opts: []vtypes.SliceOption{vtypes.WithSplitPattern(regexp.MustCompile(`\s+`)), vtypes.WithSeparator(" ")}},
+		{name: "bounds", setup: func(s *vtypes.Slice) { s.MinLen, s.MaxLen = 1, 1 }},
+		{name: "escape multi-rune separator", opts: []vtypes.SliceOption{vtypes.WithEscape(), vtypes.WithSeparator(", ")}, wantErr: true},
+		{name: "csv without split", opts: []vtypes.SliceOption{vtypes.WithCSV(), vtypes.WithSplitEach(false)}, wantErr: true},
+		{name: "escape without split", opts: []vtypes.SliceOption{vtypes.WithEscape(), vtypes.WithSplitEach(false)}, wantErr: true},
+		{name: "pattern with csv", opts: []vtypes.SliceOption{vtypes.WithSplitPattern(regexp.MustCompile(`\s+`)), vtypes.WithCSV()}, wantErr: true},
+		{name: "custom separator without split", opts: []vtypes.SliceOption{vtypes.WithSeparator(";"), vtypes.WithSplitEach(false)}, wantErr: true},
+		{name: "empty separator", opts: []vtypes.SliceOption{vtypes.WithSeparator("")}, wantErr: true},
+		{name: "negative len", setup: func(s *vtypes.Slice) { s.MinLen = -1 }, wantErr: true},
+		{name: "min exceeds max", setup: func(s *vtypes.Slice) { s.MinLen, s.MaxLen = 3, 2 }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := vtypes.MakeSlice(new([]string), tt.opts...)
+			if tt.setup != nil {
+				tt.setup(&s)
+			}
+
+			err := s.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, vtypes.ErrInvalidConfig) {
+				t.Errorf("Expected ErrInvalidConfig, got %v", err)
+			}
+		})
+	}
+
+	s := vtypes.MakeSlice(new([]string), vtypes.WithCSV(), vtypes.WithSeparator("ab"), vtypes.WithSplitEach(false))
+	if err := s.Validate(); err == nil || strings.Count(err.Error(), "invalid configuration") != 3 {
+		t.Errorf("Expected three configuration errors, got %v", err)
+	}
+}
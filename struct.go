@@ -0,0 +1,141 @@
+package vtypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Struct is an implementation of TextMarshalUnmarshaler that wraps a struct
+// value (possibly with multiple levels of pointers), hydrating its fields from
+// text in the form "k1=v1,k2=v2". Keys are matched to exported fields by their
+// `vtype:"name"` tag, or by field name when untagged; fields tagged with "-"
+// are ignored. Unknown keys are an error, and fields without a provided key are
+// left unchanged.
+type Struct struct {
+	ptrValue any // Stores the original value (e.g., *Config, **Config)
+
+	Separator   string
+	KVSeparator string
+}
+
+// MakeStruct returns an instance of Struct.
+func MakeStruct(ptrValue any) Struct {
+	return Struct{
+		ptrValue:    ptrValue,
+		Separator:   ",", // Default separator between pairs
+		KVSeparator: "=", // Default separator between key and value
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (s *Struct) UnmarshalText(text []byte) error {
+	return s.unmarshalTextContext(context.Background(), text)
+}
+
+// unmarshalTextContext is like UnmarshalText, but hydrates fields using ctx.
+func (s *Struct) unmarshalTextContext(ctx context.Context, text []byte) error {
+	// Preserve nil state if no text
+	if len(text) == 0 {
+		return nil
+	}
+
+	v := reflect.ValueOf(s.ptrValue)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			// Initialize only if we have values to add
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct || !v.CanSet() {
+		return errors.New("struct: contained value is not a pointer to a struct")
+	}
+
+	fields := structFields(v.Type())
+
+	for _, pair := range strings.Split(string(text), s.Separator) {
+		if len(pair) == 0 {
+			continue // Skip empty pairs
+		}
+
+		key, raw, ok := strings.Cut(pair, s.KVSeparator)
+		if !ok {
+			return fmt.Errorf("struct: unmarshal text: malformed pair %q", pair)
+		}
+
+		i, ok := fields[key]
+		if !ok {
+			return fmt.Errorf("struct: unmarshal text: %w: unknown key %q", ErrValueUnsupported, key)
+		}
+
+		if err := HydrateContext(ctx, v.Field(i).Addr().Interface(), raw); err != nil {
+			return NewPathError(err, "struct", "."+key)
+		}
+	}
+
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler]. Fields are rendered in
+// declaration order using [DefaultValueText].
+func (s *Struct) MarshalText() ([]byte, error) {
+	v := reflect.ValueOf(s.ptrValue)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, nil // Return nil text for nil pointers
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, errors.New("struct: contained value is not a pointer to a struct")
+	}
+
+	t := v.Type()
+	var out []string
+	for i := 0; i < t.NumField(); i++ {
+		key, ok := structFieldKey(t.Field(i))
+		if !ok {
+			continue
+		}
+		text := DefaultValueText(v.Field(i).Addr().Interface())
+		out = append(out, key+s.KVSeparator+text)
+	}
+	return []byte(strings.Join(out, s.Separator)), nil
+}
+
+// Value returns the original value with its pointer chain.
+func (s *Struct) Value() any {
+	return s.ptrValue
+}
+
+// structFields returns the field indexes of t keyed by their text key.
+func structFields(t reflect.Type) map[string]int {
+	fields := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if key, ok := structFieldKey(t.Field(i)); ok {
+			fields[key] = i
+		}
+	}
+	return fields
+}
+
+// structFieldKey returns the text key of f, if it can be hydrated.
+func structFieldKey(f reflect.StructField) (string, bool) {
+	if !f.IsExported() {
+		return "", false
+	}
+
+	tag := f.Tag.Get("vtype")
+	switch tag {
+	case "-":
+		return "", false
+	case "":
+		return f.Name, true
+	default:
+		return tag, true
+	}
+}
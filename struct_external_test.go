@@ -0,0 +1,80 @@
+package vtypes_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/daved/vtypes"
+)
+
+type serverConfig struct {
+	Host    string        `vtype:"host"`
+	Port    int           `vtype:"port"`
+	Timeout time.Duration `vtype:"timeout"`
+	Debug   bool
+	Secret  string `vtype:"-"`
+	note    string
+}
+
+func TestStruct(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       string
+		want      serverConfig
+		wantErr   bool
+		wantErrIs error
+		wantPath  string
+	}{
+		{
+			name: "tagged",
+			raw:  "host=localhost,port=8080",
+			want: serverConfig{Host: "localhost", Port: 8080},
+		},
+		{
+			name: "untagged and duration",
+			raw:  "Debug=true,timeout=5s",
+			want: serverConfig{Timeout: 5 * time.Second, Debug: true},
+		},
+		{name: "unknown key", raw: "host=x,color=red", wantErr: true, wantErrIs: vtypes.ErrValueUnsupported},
+		{name: "ignored field", raw: "Secret=x", wantErr: true, wantErrIs: vtypes.ErrValueUnsupported},
+		{name: "unexported field", raw: "note=x", wantErr: true, wantErrIs: vtypes.ErrValueUnsupported},
+		{name: "malformed pair", raw: "host", wantErr: true},
+		{name: "invalid value", raw: "port=http", wantErr: true, wantPath: ".port"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *serverConfig // nil, exercised through a double pointer
+			sv := vtypes.MakeStruct(&got)
+
+			err := vtypes.Hydrate(&sv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("Expected %v in chain, got %v", tt.wantErrIs, err)
+			}
+			if tt.wantPath != "" && vtypes.ErrorPath(err) != tt.wantPath {
+				t.Errorf("Expected path %q, got %q", tt.wantPath, vtypes.ErrorPath(err))
+			}
+			if tt.wantErr {
+				return
+			}
+			if got == nil || !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("Expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestStructMarshalText(t *testing.T) {
+	cfg := serverConfig{Host: "localhost", Port: 80, Timeout: time.Minute}
+	sv := vtypes.MakeStruct(&cfg)
+
+	want := "host=localhost,port=80,timeout=1m0s,Debug=false"
+	if text := vtypes.DefaultValueText(&sv); text != want {
+		t.Errorf("Expected default text %q, got %q", want, text)
+	}
+}
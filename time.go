@@ -0,0 +1,41 @@
+package vtypes
+
+import "time"
+
+// Time is an implementation of TextMarshalUnmarshaler that wraps a time.Time
+// value which is parsed and formatted using a configurable layout.
+type Time struct {
+	ptr *time.Time
+
+	Layout string
+}
+
+// MakeTime returns an instance of Time. If layout is empty, [time.RFC3339] is
+// used.
+func MakeTime(ptr *time.Time, layout string) Time {
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	return Time{
+		ptr:    ptr,
+		Layout: layout,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (t *Time) UnmarshalText(text []byte) error {
+	v, err := time.Parse(t.Layout, string(text))
+	if err != nil {
+		return err
+	}
+	*t.ptr = v
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (t *Time) MarshalText() ([]byte, error) {
+	if t.ptr == nil {
+		return nil, nil
+	}
+	return []byte(t.ptr.Format(t.Layout)), nil
+}
@@ -0,0 +1,48 @@
+package vtypes_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daved/vtypes"
+)
+
+func TestTimeLayout(t *testing.T) {
+	var got time.Time
+	tv := vtypes.MakeTime(&got, "2006-01-02")
+
+	if err := vtypes.Hydrate(&tv, "2024-03-15"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	want := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	if text := vtypes.DefaultValueText(&tv); text != "2024-03-15" {
+		t.Errorf("Expected default text 2024-03-15, got %q", text)
+	}
+
+	err := vtypes.Hydrate(&tv, "15/03/2024")
+	var perr *time.ParseError
+	if !errors.As(err, &perr) {
+		t.Errorf("Expected *time.ParseError in chain, got %v", err)
+	}
+	var herr *vtypes.HydrateError
+	if !errors.As(err, &herr) {
+		t.Errorf("Expected *vtypes.HydrateError in chain, got %v", err)
+	}
+}
+
+func TestTimeDefaultLayout(t *testing.T) {
+	var got time.Time
+	tv := vtypes.MakeTime(&got, "")
+
+	if err := vtypes.Hydrate(&tv, "2024-03-15T10:30:00Z"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if text := vtypes.DefaultValueText(&tv); text != "2024-03-15T10:30:00Z" {
+		t.Errorf("Expected default text 2024-03-15T10:30:00Z, got %q", text)
+	}
+}
@@ -0,0 +1,106 @@
+package vtypes
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// TimeUnit identifies the unit of the integer text used by [UnixTime].
+type TimeUnit int
+
+// TimeUnit values.
+const (
+	UnixSeconds TimeUnit = iota
+	UnixMilli
+	UnixMicro
+	UnixNano
+)
+
+// String implements [fmt.Stringer].
+func (u TimeUnit) String() string {
+	switch u {
+	case UnixSeconds:
+		return "s"
+	case UnixMilli:
+		return "ms"
+	case UnixMicro:
+		return "us"
+	case UnixNano:
+		return "ns"
+	default:
+		return fmt.Sprintf("TimeUnit(%d)", int(u))
+	}
+}
+
+// UnixTime is an implementation of TextMarshalUnmarshaler that wraps a
+// time.Time value which is parsed from and formatted as an integer count of
+// Unit since the Unix epoch. Negative (pre-epoch) values are allowed. Parsed
+// times are in UTC.
+type UnixTime struct {
+	ptr *time.Time
+
+	Unit TimeUnit
+}
+
+// MakeUnixTime returns an instance of UnixTime.
+func MakeUnixTime(ptr *time.Time, unit TimeUnit) UnixTime {
+	return UnixTime{
+		ptr:  ptr,
+		Unit: unit,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (u *UnixTime) UnmarshalText(text []byte) error {
+	n, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	var t time.Time
+	switch u.Unit {
+	case UnixSeconds:
+		t = time.Unix(n, 0)
+	case UnixMilli:
+		t = time.UnixMilli(n)
+	case UnixMicro:
+		t = time.UnixMicro(n)
+	case UnixNano:
+		t = time.Unix(0, n)
+	default:
+		return fmt.Errorf("%w: unix time unit %v", ErrValueUnsupported, u.Unit)
+	}
+
+	*u.ptr = t.UTC()
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (u *UnixTime) MarshalText() ([]byte, error) {
+	if u.ptr == nil {
+		return nil, nil
+	}
+
+	var n int64
+	switch u.Unit {
+	case UnixSeconds:
+		n = u.ptr.Unix()
+	case UnixMilli:
+		n = u.ptr.UnixMilli()
+	case UnixMicro:
+		n = u.ptr.UnixMicro()
+	case UnixNano:
+		n = u.ptr.UnixNano()
+	default:
+		return nil, fmt.Errorf("%w: unix time unit %v", ErrValueUnsupported, u.Unit)
+	}
+
+	return []byte(strconv.FormatInt(n, 10)), nil
+}
+
+// ValueTypeName returns the name of the wrapped type along with its unit
+// (e.g., "unix(ms)").
+func (u *UnixTime) ValueTypeName() string {
+	return fmt.Sprintf("unix(%v)", u.Unit)
+}
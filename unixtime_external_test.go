@@ -0,0 +1,66 @@
+package vtypes_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daved/vtypes"
+)
+
+func TestUnixTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		unit    vtypes.TimeUnit
+		raw     string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "seconds", unit: vtypes.UnixSeconds, raw: "1700000000", want: time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC)},
+		{name: "milli", unit: vtypes.UnixMilli, raw: "1700000000123", want: time.Date(2023, 11, 14, 22, 13, 20, 123e6, time.UTC)},
+		{name: "micro", unit: vtypes.UnixMicro, raw: "1700000000123456", want: time.Date(2023, 11, 14, 22, 13, 20, 123456e3, time.UTC)},
+		{name: "nano", unit: vtypes.UnixNano, raw: "1700000000123456789", want: time.Date(2023, 11, 14, 22, 13, 20, 123456789, time.UTC)},
+		{name: "pre-epoch", unit: vtypes.UnixSeconds, raw: "-86400", want: time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{name: "zero", unit: vtypes.UnixMilli, raw: "0", want: time.Unix(0, 0).UTC()},
+		{name: "fraction", unit: vtypes.UnixSeconds, raw: "1.5", wantErr: true},
+		{name: "invalid", unit: vtypes.UnixSeconds, raw: "now", wantErr: true},
+		{name: "empty", unit: vtypes.UnixSeconds, raw: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got time.Time
+			uv := vtypes.MakeUnixTime(&got, tt.unit)
+
+			err := vtypes.Hydrate(&uv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				var herr *vtypes.HydrateError
+				if !errors.As(err, &herr) {
+					t.Errorf("Expected *vtypes.HydrateError in chain, got %v", err)
+				}
+				return
+			}
+			if !got.Equal(tt.want) || got.Location() != time.UTC {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+			if text := vtypes.DefaultValueText(&uv); text != tt.raw {
+				t.Errorf("Expected default text %s, got %q", tt.raw, text)
+			}
+		})
+	}
+}
+
+func TestUnixTimeText(t *testing.T) {
+	got := time.Date(2023, 11, 14, 22, 13, 20, 0, time.FixedZone("X", 3600))
+	uv := vtypes.MakeUnixTime(&got, vtypes.UnixMilli)
+
+	if name := vtypes.ValueTypeName(&uv); name != "unix(ms)" {
+		t.Errorf("Expected type name unix(ms), got %q", name)
+	}
+	if text := vtypes.DefaultValueText(&uv); text != "1699996400000" {
+		t.Errorf("Expected default text 1699996400000, got %q", text)
+	}
+}
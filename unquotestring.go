@@ -0,0 +1,65 @@
+package vtypes
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// UnquoteString is an implementation of StringSetter that wraps a string value
+// which may be provided with surrounding quotes. Values are handled as
+// follows:
+//   - "double": unquoted using [strconv.Unquote], so Go escape sequences
+//     (e.g., \n and \") are interpreted
+//   - `back`: unquoted using strconv.Unquote, so the content is kept as-is
+//   - 'single': the quotes are removed and the content is kept as-is, as in
+//     a shell (strconv.Unquote only accepts single quotes around one rune)
+//   - anything else, including values with a quote on only one end, is kept
+//     as-is
+//
+// A double quoted value containing invalid escape sequences or an unescaped
+// double quote (e.g., "a"b") results in an error.
+type UnquoteString struct {
+	ptr *string
+}
+
+// MakeUnquoteString returns an instance of UnquoteString.
+func MakeUnquoteString(ptr *string) UnquoteString {
+	return UnquoteString{
+		ptr: ptr,
+	}
+}
+
+// Set implements [StringSetter].
+func (u *UnquoteString) Set(val string) error {
+	if len(val) < 2 || val[0] != val[len(val)-1] {
+		*u.ptr = val
+		return nil
+	}
+
+	switch val[0] {
+	case '"', '`':
+		s, err := strconv.Unquote(val)
+		if err != nil {
+			return fmt.Errorf("%w: malformed quoted string %s", ErrValueUnsupported, val)
+		}
+		*u.ptr = s
+	case '\'':
+		*u.ptr = val[1 : len(val)-1]
+	default:
+		*u.ptr = val
+	}
+	return nil
+}
+
+// String implements [fmt.Stringer].
+func (u *UnquoteString) String() string {
+	if u.ptr == nil {
+		return ""
+	}
+	return *u.ptr
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (u *UnquoteString) ValueTypeName() string {
+	return "string"
+}
@@ -0,0 +1,63 @@
+package vtypes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestUnquoteString(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{name: "plain", raw: "hello", want: "hello"},
+		{name: "double", raw: `"hello"`, want: "hello"},
+		{name: "double escapes", raw: `"a\tb \"c\""`, want: "a\tb \"c\""},
+		{name: "double empty", raw: `""`, want: ""},
+		{name: "back", raw: "`a\\n`", want: `a\n`},
+		{name: "single", raw: `'hello world'`, want: "hello world"},
+		{name: "single with backslash", raw: `'a\n'`, want: `a\n`},
+		{name: "single empty", raw: `''`, want: ""},
+		{name: "leading only", raw: `"hello`, want: `"hello`},
+		{name: "trailing only", raw: `hello'`, want: `hello'`},
+		{name: "mismatched", raw: `"hello'`, want: `"hello'`},
+		{name: "lone quote", raw: `"`, want: `"`},
+		{name: "inner quotes", raw: `a "b" c`, want: `a "b" c`},
+		{name: "unescaped inner double", raw: `"a"b"`, want: "init", wantErr: true},
+		{name: "invalid escape", raw: `"a\qb"`, want: "init", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := "init"
+			uv := vtypes.MakeUnquoteString(&got)
+
+			err := vtypes.Hydrate(&uv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, vtypes.ErrValueUnsupported) {
+				t.Errorf("Expected ErrValueUnsupported in chain, got %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestUnquoteStringText(t *testing.T) {
+	got := "a b"
+	uv := vtypes.MakeUnquoteString(&got)
+
+	if name := vtypes.ValueTypeName(&uv); name != "string" {
+		t.Errorf("Expected type name string, got %q", name)
+	}
+	if text := vtypes.DefaultValueText(&uv); text != "a b" {
+		t.Errorf("Expected default text %q, got %q", "a b", text)
+	}
+}
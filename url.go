@@ -0,0 +1,48 @@
+package vtypes
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// URL is an implementation of TextMarshalUnmarshaler that wraps a url.URL
+// value. Relative URLs can be rejected by setting RequireAbsolute.
+type URL struct {
+	ptr *url.URL
+
+	RequireAbsolute bool
+}
+
+// MakeURL returns an instance of URL.
+func MakeURL(ptr *url.URL, requireAbsolute bool) URL {
+	return URL{
+		ptr:             ptr,
+		RequireAbsolute: requireAbsolute,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (u *URL) UnmarshalText(text []byte) error {
+	v, err := url.Parse(string(text))
+	if err != nil {
+		return err
+	}
+	if u.RequireAbsolute && (!v.IsAbs() || v.Host == "") {
+		return fmt.Errorf("%w: url %q is not absolute", ErrValueUnsupported, text)
+	}
+	*u.ptr = *v
+	return nil
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (u *URL) MarshalText() ([]byte, error) {
+	if u.ptr == nil {
+		return nil, nil
+	}
+	return []byte(u.ptr.String()), nil
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (u *URL) ValueTypeName() string {
+	return "url"
+}
@@ -0,0 +1,53 @@
+package vtypes_test
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/daved/vtypes"
+)
+
+func TestURLRequireAbsolute(t *testing.T) {
+	tests := []struct {
+		name            string
+		raw             string
+		requireAbsolute bool
+		wantErr         bool
+	}{
+		{name: "absolute lenient", raw: "https://example.com/x"},
+		{name: "relative lenient", raw: "/x/y"},
+		{name: "absolute strict", raw: "https://example.com/x", requireAbsolute: true},
+		{name: "relative strict", raw: "/x/y", requireAbsolute: true, wantErr: true},
+		{name: "no host strict", raw: "mailto:someone", requireAbsolute: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got url.URL
+			uv := vtypes.MakeURL(&got, tt.requireAbsolute)
+
+			err := vtypes.Hydrate(&uv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if text := vtypes.DefaultValueText(&uv); text != tt.raw {
+				t.Errorf("Expected default text %q, got %q", tt.raw, text)
+			}
+		})
+	}
+}
+
+func TestURLParseError(t *testing.T) {
+	var got url.URL
+	uv := vtypes.MakeURL(&got, false)
+
+	err := vtypes.Hydrate(&uv, "http://[::1")
+	var uerr *url.Error
+	if !errors.As(err, &uerr) {
+		t.Errorf("Expected *url.Error in chain, got %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+package vtypes
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+)
+
+// Validated is an implementation of TextMarshalUnmarshaler that wraps any value
+// supported by [Hydrate] and runs a validation func after each hydration. The
+// validation func receives the hydrated value with all pointers dereferenced.
+// A copy of the current value is hydrated and validated, and is only assigned
+// to the wrapped value once validation succeeds, so the wrapped value is left
+// unchanged on error. Copying preserves configuration and accumulated state
+// (e.g., a [Time] layout or values added by earlier calls). Wrappers that
+// refer to their own targets (e.g., [Counter]) update those targets through the
+// copy, so they are updated before validation is run.
+type Validated struct {
+	ptr      any
+	validate func(any) error
+}
+
+// MakeValidated returns an instance of Validated.
+func MakeValidated(ptr any, validate func(any) error) Validated {
+	return Validated{
+		ptr:      ptr,
+		validate: validate,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (v *Validated) UnmarshalText(text []byte) error {
+	return v.unmarshalTextContext(context.Background(), text)
+}
+
+// unmarshalTextContext is like UnmarshalText, but hydrates the wrapped value using ctx.
+func (v *Validated) unmarshalTextContext(ctx context.Context, text []byte) error {
+	rv := reflect.ValueOf(v.ptr)
+	if v.validate == nil || rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return hydrate(ctx, v.ptr, string(text))
+	}
+
+	tmp := copyBase(rv)
+	if err := hydrate(ctx, tmp.Interface(), string(text)); err != nil {
+		return err
+	}
+	if err := v.validate(tmp.Elem().Interface()); err != nil {
+		return fmt.Errorf("validate: %w", err)
+	}
+
+	// Walk the pointer chain, initializing nil pointers now that the value is
+	// known to be valid
+	for rv.Elem().Kind() == reflect.Pointer {
+		if rv.Elem().IsNil() {
+			rv.Elem().Set(reflect.New(rv.Elem().Type().Elem()))
+		}
+		rv = rv.Elem()
+	}
+	rv.Elem().Set(tmp.Elem())
+	return nil
+}
+
+// copyBase returns a pointer to a new value holding a copy of the value at the
+// end of the pointer chain rv, or the zero value if the chain contains a nil
+// pointer. As shallow copies of big.Int and big.Float share memory which
+// hydration may overwrite, and hydration does not depend on their current
+// values, they are not copied.
+func copyBase(rv reflect.Value) reflect.Value {
+	for rv.Kind() == reflect.Pointer && rv.Elem().Kind() == reflect.Pointer {
+		if rv.Elem().IsNil() {
+			rv = rv.Elem()
+			break
+		}
+		rv = rv.Elem()
+	}
+
+	base := rv.Type()
+	for base.Kind() == reflect.Pointer {
+		base = base.Elem()
+	}
+	tmp := reflect.New(base)
+
+	switch rv.Interface().(type) {
+	case *big.Int, *big.Float:
+		return tmp
+	}
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() && rv.Type().Elem() == base {
+		tmp.Elem().Set(rv.Elem())
+	}
+	return tmp
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (v *Validated) MarshalText() ([]byte, error) {
+	return []byte(DefaultValueText(v.ptr)), nil
+}
+
+// ValueTypeName returns the type name of the wrapped value.
+func (v *Validated) ValueTypeName() string {
+	return ValueTypeName(v.ptr)
+}
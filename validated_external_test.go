@@ -0,0 +1,143 @@
+package vtypes_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/daved/vtypes"
+)
+
+var errOdd = errors.New("must be even")
+
+func validateEven(v any) error {
+	if v.(int)%2 != 0 {
+		return errOdd
+	}
+	return nil
+}
+
+func TestValidated(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       string
+		want      int
+		wantErrIs error
+		wantErr   bool
+	}{
+		{name: "valid", raw: "4", want: 4},
+		{name: "invalid", raw: "3", wantErr: true, wantErrIs: errOdd},
+		{name: "unparsable", raw: "x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n *int // nil, exercised through a double pointer
+			vv := vtypes.MakeValidated(&n, validateEven)
+
+			err := vtypes.Hydrate(&vv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("Expected %v in chain, got %v", tt.wantErrIs, err)
+			}
+			if tt.want != 0 && (n == nil || *n != tt.want) {
+				t.Errorf("Expected %d, got %v", tt.want, n)
+			}
+			if tt.wantErr && n != nil {
+				t.Errorf("Expected value to be untouched, got %d", *n)
+			}
+		})
+	}
+
+	n := 2
+	vv := vtypes.MakeValidated(&n, validateEven)
+	if err := vtypes.Hydrate(&vv, "5"); !errors.Is(err, errOdd) {
+		t.Fatalf("Expected %v in chain, got %v", errOdd, err)
+	}
+	if n != 2 {
+		t.Errorf("Expected value to remain 2, got %d", n)
+	}
+}
+
+func TestValidatedText(t *testing.T) {
+	n := 2
+	vv := vtypes.MakeValidated(&n, validateEven)
+
+	if name := vtypes.ValueTypeName(&vv); name != "int" {
+		t.Errorf("Expected type name int, got %q", name)
+	}
+	if text := vtypes.DefaultValueText(&vv); text != "2" {
+		t.Errorf("Expected default text 2, got %q", text)
+	}
+}
+
+func TestValidatedWrapper(t *testing.T) {
+	var vals []int
+	sv := vtypes.MakeSlice(&vals)
+	vv := vtypes.MakeValidated(&sv, func(v any) error {
+		s := v.(vtypes.Slice)
+		return s.ValidateLen()
+	})
+
+	if err := vtypes.Hydrate(&vv, "1,2"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if len(vals) != 2 {
+		t.Errorf("Expected wrapped slice to be updated in place, got %v", vals)
+	}
+}
+
+func TestValidatedStatefulValues(t *testing.T) {
+	t.Run("counter", func(t *testing.T) {
+		var n int
+		cv := vtypes.MakeCounter(&n)
+		vv := vtypes.MakeValidated(&cv, func(any) error { return nil })
+
+		for i := 0; i < 2; i++ {
+			if err := vtypes.Hydrate(&vv, ""); err != nil {
+				t.Fatalf("Hydrate error: %v", err)
+			}
+		}
+		if n != 2 {
+			t.Errorf("Expected 2, got %d", n)
+		}
+	})
+
+	t.Run("time layout", func(t *testing.T) {
+		var got time.Time
+		tv := vtypes.MakeTime(&got, time.DateOnly)
+		vv := vtypes.MakeValidated(&tv, func(any) error { return nil })
+
+		if err := vtypes.Hydrate(&vv, "2024-02-03"); err != nil {
+			t.Fatalf("Hydrate error: %v", err)
+		}
+		if want := time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("accumulating setter", func(t *testing.T) {
+		var got tags
+		vv := vtypes.MakeValidated(&got, func(v any) error {
+			if len(v.(tags).vals) > 2 {
+				return errors.New("too many")
+			}
+			return nil
+		})
+
+		for _, raw := range []string{"a", "b"} {
+			if err := vtypes.Hydrate(&vv, raw); err != nil {
+				t.Fatalf("Hydrate error: %v", err)
+			}
+		}
+		if err := vtypes.Hydrate(&vv, "c"); err == nil {
+			t.Fatal("Expected validation error, got nil")
+		}
+		if want := []string{"a", "b"}; !reflect.DeepEqual(got.vals, want) {
+			t.Errorf("Expected %q, got %q", want, got.vals)
+		}
+	})
+}
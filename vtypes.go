@@ -2,14 +2,27 @@
 package vtypes
 
 import (
+	"context"
+	"encoding"
+	"errors"
 	"fmt"
+	"math/big"
+	"net"
+	"net/url"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 )
 
 // ConvertCompatible wraps compatible types.
 func ConvertCompatible(val any) any {
+	return ConvertCompatibleWith(val)
+}
+
+// ConvertCompatibleWith wraps compatible types like ConvertCompatible, applying
+// opts to any Slice that is created.
+func ConvertCompatibleWith(val any, opts ...SliceOption) any {
 	// Handle function types first
 	switch v := val.(type) {
 	case func(string) error:
@@ -20,54 +33,193 @@ func ConvertCompatible(val any) any {
 
 	// Get the type of val
 	t := reflect.TypeOf(val)
-	// Check if it’s a slice type (including pointers to slices)
+	// Check if it’s a slice, array, or map type (including pointers to each)
 	for t != nil && t.Kind() == reflect.Pointer {
 		t = t.Elem()
 	}
 
-	if t != nil && t.Kind() == reflect.Slice {
-		s := MakeSlice(val)
+	// Byte slices are hydrated as raw text
+	if t != nil && t.Kind() == reflect.Slice && t != bytesType && !implementsSetter(t) {
+		s := MakeSlice(val, opts...)
 		return &s
 	}
 
-	// Return original value for non-slice types
+	if t != nil && t.Kind() == reflect.Array && !implementsSetter(t) {
+		a := MakeArray(val)
+		return &a
+	}
+
+	if t != nil && t.Kind() == reflect.Map && !implementsSetter(t) {
+		m := MakeMap(val)
+		return &m
+	}
+
+	// Return original value for non-slice/array/map types
 	return val
 }
 
+var bytesType = reflect.TypeOf([]byte(nil))
+
+var setterTypes = []reflect.Type{
+	reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem(),
+	reflect.TypeOf((*StringSetter)(nil)).Elem(),
+	reflect.TypeOf((*Setter)(nil)).Elem(),
+	reflect.TypeOf((*OnSetter)(nil)).Elem(),
+}
+
+// implementsSetter reports whether t or a pointer to t handles its own
+// hydration, in which case it should not be wrapped by ConvertCompatible.
+func implementsSetter(t reflect.Type) bool {
+	pt := reflect.PointerTo(t)
+	for _, st := range setterTypes {
+		if t.Implements(st) || pt.Implements(st) {
+			return true
+		}
+	}
+	return false
+}
+
 // Hydrate will parse the raw string value and use the result to update val.
 // Valid val type values are:
-//   - builtin: *string, *bool, error, *int, *int8, *int16, *int32, *int64,
-//     *uint, *uint8, *uint16, *uint32, *uint64, *float32, *float64
-//   - stdlib: *[time.Duration], [flag.Value]
-//   - vtypes: [TextMarshalUnmarshaler], [OnSetter], [StringSetter],
-//     [OnSetFunc], [OnSetBoolFunc]
+//   - builtin: *string, *[]byte (raw), *bool, error, *int, *int8, *int16,
+//     *int32, *int64, *uint, *uint8, *uint16, *uint32, *uint64, *float32,
+//     *float64, *complex64, *complex128
+//   - stdlib: *[time.Duration], *[time.Time] (RFC3339), *[net.IP],
+//     *[net.IPNet], *[url.URL], *[big.Int], *[big.Float], [flag.Value],
+//     [database/sql.Scanner] (e.g., *[database/sql.NullString]; Scan is passed
+//     raw as a string)
+//   - vtypes: [ContextSetter], [TextMarshalUnmarshaler], [OnSetter],
+//     [StringSetter], [Setter], [OnSetFunc], [OnSetBoolFunc], [Time], [URL], [Bytes]
+//   - custom: types with a handler set using [Register]
+//   - maps: *map[K]V where K and V are supported, using [Map] defaults (each
+//     call replaces the map contents; for duplicate keys, the last wins)
+//
+// Surrounding whitespace is rejected by most builtin and stdlib types, but is
+// trimmed for *[big.Int] and *[big.Float], and is kept as-is for *string and
+// *[]byte. Use [HydrateStrict] to reject it uniformly.
 func Hydrate(val any, raw string) error {
-	wrap := func(err error) error {
-		return NewError(NewHydrateError(err, val))
+	return HydrateContext(context.Background(), val, raw)
+}
+
+// HydrateContext is like Hydrate, but passes ctx to types implementing
+// [ContextSetter] (including the elements of containers such as [Slice] and
+// the values held by wrappers such as [Validated]) so that expensive setters
+// can be cancelled. An error is returned without hydrating if ctx is already
+// done.
+func HydrateContext(ctx context.Context, val any, raw string) error {
+	if err := hydrate(ctx, val, raw); err != nil {
+		return wrapHydrateError(err, val, raw)
 	}
+	return nil
+}
 
-	tmpVal, pointerChain, err := tempValue(val)
-	if err != nil {
-		return wrap(err)
+// HydrateStrict is like Hydrate, but first rejects raw values with leading or
+// trailing whitespace (as defined by [unicode.IsSpace]) regardless of the type
+// of val, returning an error wrapping ErrValueUnsupported without hydrating.
+// Only raw as a whole is checked, so whitespace around the elements of a
+// [Slice] or [Map] is handled by the element types.
+func HydrateStrict(val any, raw string) error {
+	if raw != strings.TrimSpace(raw) {
+		err := fmt.Errorf("%w: surrounding whitespace in %q", ErrValueUnsupported, raw)
+		return wrapHydrateError(err, val, raw)
+	}
+	return Hydrate(val, raw)
+}
+
+// HydrateDebug is like Hydrate, but also returns the number of pointer levels
+// traversed to reach the hydrated value (e.g., 2 for a **int), which can help
+// to diagnose hydration that appears to have no effect. Zero is returned for
+// values that are not non-nil pointers.
+func HydrateDebug(val any, raw string) (levels int, err error) {
+	if rv := reflect.ValueOf(val); rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		for t := rv.Type(); t.Kind() == reflect.Pointer; t = t.Elem() {
+			levels++
+		}
+	}
+	return levels, Hydrate(val, raw)
+}
+
+// Field is a named value and its raw text, as used by HydrateAll.
+type Field struct {
+	Name string
+	Val  any
+	Raw  string
+}
+
+// HydrateAll hydrates each of fields, continuing past failures. Errors are
+// wrapped in a [FieldError] naming the field and combined using [errors.Join],
+// so each remains reachable by [errors.Is] and [errors.As]. Nil is returned if
+// all fields are hydrated successfully.
+func HydrateAll(fields ...Field) error {
+	var errs []error
+	for _, f := range fields {
+		if err := Hydrate(f.Val, f.Raw); err != nil {
+			errs = append(errs, NewFieldError(err, f.Name))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// HydrateValue is like Hydrate, but accepts the reflect.Value of the value to
+// be updated (e.g., a struct field) rather than a pointer to it. rv must be
+// addressable and must not be obtained through unexported struct fields;
+// otherwise, an error wrapping ErrNotPointer is returned.
+func HydrateValue(rv reflect.Value, raw string) error {
+	if !rv.IsValid() {
+		return wrapHydrateError(ErrNilValue, nil, raw)
+	}
+	if !rv.CanSet() {
+		err := fmt.Errorf("%w: reflect.Value of type %v is not addressable or is unexported", ErrNotPointer, rv.Type())
+		// Unexported values cannot be interfaced, so a zero value conveys the type
+		return wrapHydrateError(err, reflect.Zero(rv.Type()).Interface(), raw)
+	}
+	return Hydrate(rv.Addr().Interface(), raw)
+}
+
+func wrapHydrateError(err error, val any, raw string) error {
+	herr := NewHydrateError(err, val)
+	herr.Raw = raw
+	return NewError(herr)
+}
+
+// hydrate does the work of HydrateContext without wrapping errors so that
+// wrapper types can delegate to it without adding redundant error context.
+func hydrate(ctx context.Context, val any, raw string) error {
+	if err := ctx.Err(); err != nil {
+		return err
 	}
 
-	err = hydrateValue(tmpVal, raw)
+	// Common single pointers need no pointer chain handling
+	switch v := val.(type) {
+	case *string, *bool, *int, *int64, *uint, *uint64, *float64, *time.Duration:
+		if !reflect.ValueOf(v).IsNil() {
+			return hydrateValue(ctx, v, raw)
+		}
+	}
+
+	tmpVal, pointerChain, err := tempValue(val)
 	if err != nil {
-		return wrap(err)
+		return err
 	}
 
-	err = assignThroughChain(tmpVal, pointerChain)
+	err = hydrateValue(ctx, tmpVal, raw)
 	if err != nil {
-		return wrap(err)
+		return err
 	}
 
-	return nil
+	return assignThroughChain(tmpVal, pointerChain)
 }
 
 func tempValue(val any) (prepared any, pointerChain []reflect.Value, err error) {
 	v := reflect.ValueOf(val)
+	if !v.IsValid() {
+		return nil, nil, ErrNilValue
+	}
 	if v.Kind() != reflect.Pointer {
-		return nil, nil, ErrTypeUnsupported
+		return nil, nil, ErrNotPointer
+	}
+	if v.IsNil() {
+		return nil, nil, ErrNilValue
 	}
 
 	// Collect all pointer levels
@@ -96,14 +248,27 @@ func tempValue(val any) (prepared any, pointerChain []reflect.Value, err error)
 }
 
 // hydrateValue handles the actual parsing and assignment to the prepared single-pointer value
-func hydrateValue(val any, raw string) error {
+func hydrateValue(ctx context.Context, val any, raw string) error {
 	switch v := val.(type) {
 	case error:
 		return v
 
+	case ContextSetter:
+		if err := v.SetContext(ctx, raw); err != nil {
+			return err
+		}
+
+	case contextUnmarshaler:
+		if err := v.unmarshalTextContext(ctx, []byte(raw)); err != nil {
+			return err
+		}
+
 	case *string:
 		*v = raw
 
+	case *[]byte:
+		*v = []byte(raw)
+
 	case *bool:
 		b, err := strconv.ParseBool(raw)
 		if err != nil {
@@ -114,70 +279,70 @@ func hydrateValue(val any, raw string) error {
 	case *int:
 		n, err := strconv.Atoi(raw)
 		if err != nil {
-			return err
+			return intRangeError(err, raw, reflect.Int)
 		}
 		*v = n
 
 	case *int64:
 		n, err := strconv.ParseInt(raw, 10, 0)
 		if err != nil {
-			return err
+			return intRangeError(err, raw, reflect.Int64)
 		}
 		*v = n
 
 	case *int8:
 		n, err := strconv.ParseInt(raw, 10, 8)
 		if err != nil {
-			return err
+			return intRangeError(err, raw, reflect.Int8)
 		}
 		*v = int8(n)
 
 	case *int16:
 		n, err := strconv.ParseInt(raw, 10, 16)
 		if err != nil {
-			return err
+			return intRangeError(err, raw, reflect.Int16)
 		}
 		*v = int16(n)
 
 	case *int32:
 		n, err := strconv.ParseInt(raw, 10, 32)
 		if err != nil {
-			return err
+			return intRangeError(err, raw, reflect.Int32)
 		}
 		*v = int32(n)
 
 	case *uint:
 		n, err := strconv.ParseUint(raw, 10, 0)
 		if err != nil {
-			return err
+			return intRangeError(err, raw, reflect.Uint)
 		}
 		*v = uint(n)
 
 	case *uint64:
 		n, err := strconv.ParseUint(raw, 10, 0)
 		if err != nil {
-			return err
+			return intRangeError(err, raw, reflect.Uint64)
 		}
 		*v = n
 
 	case *uint8:
 		n, err := strconv.ParseUint(raw, 10, 8)
 		if err != nil {
-			return err
+			return intRangeError(err, raw, reflect.Uint8)
 		}
 		*v = uint8(n)
 
 	case *uint16:
 		n, err := strconv.ParseUint(raw, 10, 16)
 		if err != nil {
-			return err
+			return intRangeError(err, raw, reflect.Uint16)
 		}
 		*v = uint16(n)
 
 	case *uint32:
 		n, err := strconv.ParseUint(raw, 10, 32)
 		if err != nil {
-			return err
+			return intRangeError(err, raw, reflect.Uint32)
 		}
 		*v = uint32(n)
 
@@ -195,6 +360,20 @@ func hydrateValue(val any, raw string) error {
 		}
 		*v = float32(f)
 
+	case *complex128:
+		c, err := strconv.ParseComplex(raw, 128)
+		if err != nil {
+			return err
+		}
+		*v = c
+
+	case *complex64:
+		c, err := strconv.ParseComplex(raw, 64)
+		if err != nil {
+			return err
+		}
+		*v = complex64(c)
+
 	case *time.Duration:
 		d, err := time.ParseDuration(raw)
 		if err != nil {
@@ -202,6 +381,53 @@ func hydrateValue(val any, raw string) error {
 		}
 		*v = d
 
+	case *time.Time:
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return err
+		}
+		*v = t
+
+	case *net.IP:
+		if raw == "" {
+			*v = nil // Matches net.IP.UnmarshalText
+			break
+		}
+		ip := net.ParseIP(raw)
+		if ip == nil {
+			return fmt.Errorf("%w: invalid ip address %q", ErrValueUnsupported, raw)
+		}
+		*v = ip
+
+	case *net.IPNet:
+		_, n, err := net.ParseCIDR(raw)
+		if err != nil {
+			return err
+		}
+		*v = *n
+
+	case *url.URL:
+		u, err := url.Parse(raw)
+		if err != nil {
+			return err
+		}
+		*v = *u
+
+	case *big.Int:
+		// Base 10 keeps zero-padded input (e.g., "010") decimal
+		n, ok := new(big.Int).SetString(strings.TrimSpace(raw), 10)
+		if !ok {
+			return fmt.Errorf("%w: invalid big integer %q", ErrValueUnsupported, raw)
+		}
+		v.Set(n)
+
+	case *big.Float:
+		f, ok := new(big.Float).SetString(strings.TrimSpace(raw))
+		if !ok {
+			return fmt.Errorf("%w: invalid big float %q", ErrValueUnsupported, raw)
+		}
+		v.Set(f)
+
 	case TextMarshalUnmarshaler:
 		if err := v.UnmarshalText([]byte(raw)); err != nil {
 			return err
@@ -217,13 +443,92 @@ func hydrateValue(val any, raw string) error {
 			return err
 		}
 
+	case Setter:
+		if err := v.Set(raw); err != nil {
+			return err
+		}
+
+	case scanner:
+		if err := v.Scan(raw); err != nil {
+			return err
+		}
+
 	default:
+		t := reflect.TypeOf(val).Elem()
+		if fn, ok := registered(t); ok {
+			return fn(val, raw)
+		}
+
+		if t.Kind() == reflect.Map {
+			m := MakeMap(val)
+			return m.UnmarshalText([]byte(raw))
+		}
+
 		return ErrTypeUnsupported
 	}
 
 	return nil
 }
 
+// intRangeError returns err with a message describing the range of kind if err
+// is a [strconv.ErrRange] error, or stating that the value must be
+// non-negative if kind is unsigned and raw is a negative number. The original
+// error remains in the chain. Otherwise, err is returned as-is.
+func intRangeError(err error, raw string, kind reflect.Kind) error {
+	if isUnsignedKind(kind) && isNegativeNumber(raw) {
+		return fmt.Errorf("value %s must be non-negative for %v: %w", raw, kind, err)
+	}
+	if !errors.Is(err, strconv.ErrRange) {
+		return err
+	}
+
+	bits := strconv.IntSize
+	switch kind {
+	case reflect.Int8, reflect.Uint8:
+		bits = 8
+	case reflect.Int16, reflect.Uint16:
+		bits = 16
+	case reflect.Int32, reflect.Uint32:
+		bits = 32
+	case reflect.Int64, reflect.Uint64:
+		bits = 64
+	}
+
+	var rng string
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		max := int64(1)<<(bits-1) - 1
+		rng = fmt.Sprintf("%d..%d", -max-1, max)
+	default:
+		rng = fmt.Sprintf("0..%d", ^uint64(0)>>(64-bits))
+	}
+
+	return fmt.Errorf("value %s overflows %v (range %s): %w", raw, kind, rng, err)
+}
+
+func isUnsignedKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+// isNegativeNumber reports whether raw is a minus sign followed by decimal
+// digits (e.g., "-1" or "-0").
+func isNegativeNumber(raw string) bool {
+	digits, ok := strings.CutPrefix(raw, "-")
+	if !ok || digits == "" {
+		return false
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 // assignThroughChain propagates the value back through the pointer chain
 func assignThroughChain(prepared any, pointerChain []reflect.Value) error {
 	if len(pointerChain) == 0 {
@@ -256,13 +561,31 @@ func ValueTypeName(val any) string {
 	case ValueTypeNamer:
 		return v.ValueTypeName()
 
+	case *net.IP:
+		return "ip"
+
+	case *net.IPNet:
+		return "cidr"
+
+	case *url.URL:
+		return "url"
+
+	case *[]byte:
+		return "bytes"
+
+	case *big.Int:
+		return "bigint"
+
+	case *big.Float:
+		return "bigfloat"
+
 	case interface{ IsBool() bool }:
 		if v.IsBool() {
 			return "bool"
 		}
 		return "value"
 
-	case TextMarshalUnmarshaler, StringSetter:
+	case TextMarshalUnmarshaler, StringSetter, Setter, ContextSetter:
 		return "value"
 
 	case nil, error:
@@ -283,11 +606,55 @@ func ValueTypeName(val any) string {
 
 // DefaultValueText returns a "best effort" text representation of the value.
 // Explicit values are communicated by types implementing [DefaultValueTexter].
+// Otherwise, a function set by [SetDefaultTextFunc] for the type pointed to by
+// val is used if one exists.
 func DefaultValueText(val any) string {
+	if _, ok := val.(DefaultValueTexter); !ok {
+		if fn, ok := defaultTextFunc(val); ok {
+			return fn(val)
+		}
+	}
+
 	switch v := val.(type) {
 	case DefaultValueTexter:
 		return v.DefaultValueText()
 
+	case *net.IP:
+		if v == nil || *v == nil {
+			return ""
+		}
+		return v.String()
+
+	case *net.IPNet:
+		if v == nil {
+			return ""
+		}
+		return v.String()
+
+	case *url.URL:
+		if v == nil {
+			return ""
+		}
+		return v.String()
+
+	case *[]byte:
+		if v == nil {
+			return ""
+		}
+		return string(*v)
+
+	case *big.Int:
+		if v == nil {
+			return ""
+		}
+		return v.String()
+
+	case *big.Float:
+		if v == nil {
+			return ""
+		}
+		return v.Text('g', -1)
+
 	case TextMarshalUnmarshaler:
 		t, err := v.MarshalText()
 		if err != nil {
@@ -301,6 +668,16 @@ func DefaultValueText(val any) string {
 	case fmt.Stringer:
 		return v.String()
 
+	case valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return err.Error()
+		}
+		if dv == nil {
+			return ""
+		}
+		return fmt.Sprint(dv)
+
 	default:
 		if reflect.ValueOf(val).Kind() == reflect.Func {
 			return ""
@@ -311,6 +688,11 @@ func DefaultValueText(val any) string {
 			if vo.IsNil() {
 				return ""
 			}
+			// Deeper pointers may implement fmt.Stringer (e.g., **T where *T
+			// has a pointer receiver String method).
+			if s, ok := vo.Interface().(fmt.Stringer); ok {
+				return s.String()
+			}
 			vo = vo.Elem()
 		}
 		return fmt.Sprint(vo)
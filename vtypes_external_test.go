@@ -1,7 +1,20 @@
 package vtypes_test
 
 import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/url"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -22,7 +35,20 @@ func TestSurfaceHydrate(t *testing.T) {
 		{name: "int single", input: new(int), raw: "42", want: ptr(42)},
 		{name: "uint single", input: new(uint), raw: "42", want: ptr(uint(42))},
 		{name: "float64 single", input: new(float64), raw: "3.14", want: ptr(3.14)},
+		{name: "bytes single", input: new([]byte), raw: "hi", want: ptr([]byte("hi"))},
+		{name: "complex128 single", input: new(complex128), raw: "1+2i", want: ptr(complex(1, 2))},
+		{name: "complex128 real only", input: new(complex128), raw: "3.5", want: ptr(complex(3.5, 0))},
+		{name: "complex64 single", input: new(complex64), raw: "(1.5-2i)", want: ptr(complex64(complex(1.5, -2)))},
 		{name: "duration single", input: new(time.Duration), raw: "1h", want: ptr(time.Hour)},
+		{name: "ip single", input: new(net.IP), raw: "192.168.0.1", want: ptr(net.ParseIP("192.168.0.1"))},
+		{name: "ip empty", input: ptr(net.ParseIP("192.168.0.1")), raw: "", want: new(net.IP)},
+		{name: "cidr single", input: new(net.IPNet), raw: "10.0.0.0/8", want: ptr(mustCIDR("10.0.0.0/8"))},
+		{name: "url single", input: new(url.URL), raw: "https://example.com/a?b=c", want: ptr(url.URL{Scheme: "https", Host: "example.com", Path: "/a", RawQuery: "b=c"})},
+		{name: "bigint single", input: new(big.Int), raw: " 123456789012345678901234567890 ", want: mustBigInt("123456789012345678901234567890")},
+		{name: "bigint zero padded", input: new(big.Int), raw: "010", want: big.NewInt(10)},
+		{name: "bigint leading zero", input: new(big.Int), raw: "09", want: big.NewInt(9)},
+		{name: "bigfloat single", input: new(big.Float), raw: "1.5\n", want: new(big.Float).SetPrec(64).SetFloat64(1.5)},
+		{name: "time single", input: new(time.Time), raw: "2024-01-02T03:04:05Z", want: ptr(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))},
 
 		// Double pointer tests
 		{name: "string double", input: ptr(new(string)), raw: "hello", want: ptr(ptr("hello"))},
@@ -39,6 +65,14 @@ func TestSurfaceHydrate(t *testing.T) {
 		{name: "invalid bool", input: new(bool), raw: "notabool", wantErr: true},
 		{name: "invalid int", input: new(int), raw: "notanint", wantErr: true},
 		{name: "invalid float", input: new(float64), raw: "notafloat", wantErr: true},
+		{name: "invalid complex", input: new(complex128), raw: "1+2j", wantErr: true},
+		{name: "invalid ip", input: new(net.IP), raw: "not.an.ip", wantErr: true},
+		{name: "invalid cidr", input: new(net.IPNet), raw: "10.0.0.0", wantErr: true},
+		{name: "invalid url", input: new(url.URL), raw: "http://[::1", wantErr: true},
+		{name: "invalid bigint", input: new(big.Int), raw: "1.5", wantErr: true},
+		{name: "prefixed bigint", input: new(big.Int), raw: "0x10", wantErr: true},
+		{name: "invalid bigfloat", input: new(big.Float), raw: "abc", wantErr: true},
+		{name: "invalid time", input: new(time.Time), raw: "2024-01-02", wantErr: true},
 	}
 
 	for _, tt := range tests {
@@ -72,6 +106,92 @@ func ptr[T any](v T) *T {
 	return &v
 }
 
+// mustCIDR is a helper to create an IPNet from valid CIDR notation
+func mustCIDR(s string) net.IPNet {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return *n
+}
+
+// mustBigInt is a helper to create a big.Int from a valid base 10 string
+func mustBigInt(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("invalid big.Int: " + s)
+	}
+	return n
+}
+
+func TestSurfaceValueTypeName(t *testing.T) {
+	tests := []struct {
+		name  string
+		input any
+		want  string
+	}{
+		{name: "int", input: new(int), want: "int"},
+		{name: "int double", input: ptr(new(int)), want: "int"},
+		{name: "complex64", input: new(complex64), want: "complex64"},
+		{name: "complex128", input: new(complex128), want: "complex128"},
+		{name: "bytes", input: new([]byte), want: "bytes"},
+		{name: "ip", input: new(net.IP), want: "ip"},
+		{name: "cidr", input: new(net.IPNet), want: "cidr"},
+		{name: "url", input: new(url.URL), want: "url"},
+		{name: "bigint", input: new(big.Int), want: "bigint"},
+		{name: "bigfloat", input: new(big.Float), want: "bigfloat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vtypes.ValueTypeName(tt.input); got != tt.want {
+				t.Errorf("ValueTypeName() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// ptrStringer implements fmt.Stringer with a pointer receiver
+type ptrStringer struct{ n int }
+
+func (s *ptrStringer) String() string { return fmt.Sprintf("ptr(%d)", s.n) }
+
+// valStringer implements fmt.Stringer with a value receiver
+type valStringer struct{ n int }
+
+func (s valStringer) String() string { return fmt.Sprintf("val(%d)", s.n) }
+
+func TestSurfaceDefaultValueText(t *testing.T) {
+	tests := []struct {
+		name  string
+		input any
+		want  string
+	}{
+		{name: "int", input: ptr(42), want: "42"},
+		{name: "int double", input: ptr(ptr(42)), want: "42"},
+		{name: "bytes", input: ptr([]byte("hi")), want: "hi"},
+		{name: "ip", input: ptr(net.ParseIP("::1")), want: "::1"},
+		{name: "ip nil", input: new(net.IP), want: ""},
+		{name: "cidr", input: ptr(mustCIDR("10.0.0.0/8")), want: "10.0.0.0/8"},
+		{name: "url", input: &url.URL{Scheme: "https", Host: "example.com"}, want: "https://example.com"},
+		{name: "bigint", input: mustBigInt("-12345678901234567890"), want: "-12345678901234567890"},
+		{name: "bigfloat", input: big.NewFloat(2.25), want: "2.25"},
+		{name: "bigfloat precise", input: big.NewFloat(1.23456789012345), want: "1.23456789012345"},
+		{name: "pointer stringer", input: &ptrStringer{1}, want: "ptr(1)"},
+		{name: "pointer stringer double", input: ptr(&ptrStringer{1}), want: "ptr(1)"},
+		{name: "value stringer", input: &valStringer{1}, want: "val(1)"},
+		{name: "value stringer double", input: ptr(&valStringer{1}), want: "val(1)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vtypes.DefaultValueText(tt.input); got != tt.want {
+				t.Errorf("DefaultValueText() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestConvertCompatibleWithDoublePointerNilSlice(t *testing.T) {
 	var slice *[]int // nil
 	p1 := &slice     // **[]int where *[]int is nil
@@ -169,3 +289,968 @@ func TestConvertCompatibleWithSinglePointerSlice(t *testing.T) {
 		t.Errorf("Expected slice values [1, 2, 3], got %v", *slicePtr)
 	}
 }
+
+func TestSurfaceHydrateErrorsIs(t *testing.T) {
+	tests := []struct {
+		name  string
+		input func() any
+		raw   string
+	}{
+		{name: "non-pointer", input: func() any { return 1 }, raw: "1"},
+		{name: "unsupported pointer", input: func() any { return new(chan int) }, raw: "1"},
+		{name: "unsupported slice element", input: func() any {
+			s := vtypes.MakeSlice(new([]chan int))
+			return &s
+		}, raw: "1,2"},
+		{name: "unsupported map value", input: func() any {
+			m := vtypes.MakeMap(new(map[string]chan int))
+			return &m
+		}, raw: "a=1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := vtypes.Hydrate(tt.input(), tt.raw)
+			if !errors.Is(err, vtypes.ErrTypeUnsupported) {
+				t.Errorf("Expected ErrTypeUnsupported in chain, got %v", err)
+			}
+			if errors.Is(err, vtypes.ErrValueUnsupported) {
+				t.Errorf("Expected no ErrValueUnsupported in chain, got %v", err)
+			}
+
+			var verr *vtypes.Error
+			if !errors.As(err, &verr) {
+				t.Errorf("Expected *vtypes.Error in chain, got %v", err)
+			}
+			var herr *vtypes.HydrateError
+			if !errors.As(err, &herr) {
+				t.Errorf("Expected *vtypes.HydrateError in chain, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSurfaceHydrateErrorsIsSpecific(t *testing.T) {
+	tests := []struct {
+		name string
+		val  any
+		want error
+	}{
+		{name: "nil", val: nil, want: vtypes.ErrNilValue},
+		{name: "nil pointer", val: (*int)(nil), want: vtypes.ErrNilValue},
+		{name: "nil double pointer", val: (**int)(nil), want: vtypes.ErrNilValue},
+		{name: "non-pointer", val: 1, want: vtypes.ErrNotPointer},
+		{name: "non-pointer string", val: "x", want: vtypes.ErrNotPointer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := vtypes.Hydrate(tt.val, "1")
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v in chain, got %v", tt.want, err)
+			}
+			if !errors.Is(err, vtypes.ErrTypeUnsupported) {
+				t.Errorf("Expected ErrTypeUnsupported in chain, got %v", err)
+			}
+		})
+	}
+
+	err := vtypes.Hydrate(new(chan int), "1")
+	if errors.Is(err, vtypes.ErrNotPointer) || errors.Is(err, vtypes.ErrNilValue) {
+		t.Errorf("Expected only ErrTypeUnsupported for unsupported pointer, got %v", err)
+	}
+}
+
+func TestSurfaceHydrateStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		val     any
+		raw     string
+		wantErr bool
+	}{
+		{name: "int", val: new(int), raw: "1"},
+		{name: "int leading", val: new(int), raw: " 1", wantErr: true},
+		{name: "bool trailing", val: new(bool), raw: "true\n", wantErr: true},
+		{name: "string", val: new(string), raw: "a b"},
+		{name: "string surrounding", val: new(string), raw: " a ", wantErr: true},
+		{name: "bigint", val: new(big.Int), raw: "12"},
+		{name: "bigint tab", val: new(big.Int), raw: "\t12", wantErr: true},
+		{name: "empty", val: new(string), raw: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := vtypes.HydrateStrict(tt.val, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HydrateStrict() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				return
+			}
+			if !errors.Is(err, vtypes.ErrValueUnsupported) {
+				t.Errorf("Expected ErrValueUnsupported in chain, got %v", err)
+			}
+			var herr *vtypes.HydrateError
+			if !errors.As(err, &herr) || herr.Raw != tt.raw {
+				t.Errorf("Expected *vtypes.HydrateError with raw %q, got %v", tt.raw, err)
+			}
+		})
+	}
+
+	s := " a "
+	if err := vtypes.HydrateStrict(&s, " b"); err == nil || s != " a " {
+		t.Errorf("Expected value to be untouched, got %q (err: %v)", s, err)
+	}
+
+	// The lenient types accept the same input through Hydrate.
+	n := new(big.Int)
+	if err := vtypes.Hydrate(n, "\t12"); err != nil || n.Int64() != 12 {
+		t.Errorf("Expected Hydrate to trim big.Int input, got %v (err: %v)", n, err)
+	}
+}
+
+func TestSurfaceErrorIsSemantics(t *testing.T) {
+	err := vtypes.Hydrate(new(int), "x")
+
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) {
+		t.Fatalf("Expected *strconv.NumError in chain, got %v", err)
+	}
+
+	if errors.Is(err, &vtypes.Error{}) {
+		t.Error("Expected no match for an empty *vtypes.Error")
+	}
+	if errors.Is(err, &vtypes.HydrateError{}) {
+		t.Error("Expected no match for an empty *vtypes.HydrateError")
+	}
+	if errors.Is(err, vtypes.NewError(errors.New("other"))) {
+		t.Error("Expected no match for a *vtypes.Error with a different child")
+	}
+
+	var verr *vtypes.Error
+	if !errors.As(err, &verr) {
+		t.Fatalf("Expected *vtypes.Error in chain, got %v", err)
+	}
+	var herr *vtypes.HydrateError
+	if !errors.As(err, &herr) {
+		t.Fatalf("Expected *vtypes.HydrateError in chain, got %v", err)
+	}
+	if !errors.Is(err, vtypes.NewHydrateError(numErr, nil)) {
+		t.Error("Expected match for a *vtypes.HydrateError with the same child")
+	}
+	if !errors.Is(err, vtypes.NewError(herr)) {
+		t.Error("Expected match for a *vtypes.Error with the same child")
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Error("Expected strconv.ErrSyntax to match through Unwrap")
+	}
+	if errors.Is(err, io.EOF) {
+		t.Error("Expected io.EOF not to match")
+	}
+
+	eofErr := vtypes.NewError(io.EOF)
+	if !errors.Is(eofErr, io.EOF) || !errors.Is(eofErr, vtypes.NewError(io.EOF)) {
+		t.Error("Expected io.EOF and an equivalent *vtypes.Error to match")
+	}
+}
+
+func TestSurfaceHydrateErrorRaw(t *testing.T) {
+	err := vtypes.Hydrate(new(int), "abc")
+
+	var herr *vtypes.HydrateError
+	if !errors.As(err, &herr) {
+		t.Fatalf("Expected *vtypes.HydrateError in chain, got %v", err)
+	}
+	if herr.Raw != "abc" {
+		t.Errorf("Expected raw abc, got %q", herr.Raw)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Expected strconv.ErrSyntax in chain, got %v", err)
+	}
+
+	want := `vtypes: hydrate (type: *int, raw: "abc"): strconv.Atoi: parsing "abc": invalid syntax`
+	if err.Error() != want {
+		t.Errorf("Expected error %q, got %q", want, err.Error())
+	}
+}
+
+func TestSurfaceHydrateErrorJSON(t *testing.T) {
+	err := vtypes.Hydrate(new(int), "abc")
+
+	var herr *vtypes.HydrateError
+	if !errors.As(err, &herr) {
+		t.Fatalf("Expected *vtypes.HydrateError in chain, got %v", err)
+	}
+
+	data, jerr := json.Marshal(herr)
+	if jerr != nil {
+		t.Fatalf("Marshal error: %v", jerr)
+	}
+	want := `{"type":"*int","raw":"abc","message":"strconv.Atoi: parsing \"abc\": invalid syntax"}`
+	if string(data) != want {
+		t.Errorf("Expected %s, got %s", want, data)
+	}
+
+	var got map[string]string
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if len(got) != 3 || got["type"] != "*int" || got["raw"] != "abc" {
+		t.Errorf("Expected type, raw, and message keys, got %v", got)
+	}
+}
+
+func TestSurfaceHydratePathError(t *testing.T) {
+	// Hydrate []int values using a Slice with a separator that does not clash
+	// with the Map separator.
+	typ := reflect.TypeOf([]int{})
+	vtypes.Register(typ, func(ptr any, raw string) error {
+		s := vtypes.MakeSlice(ptr)
+		s.Separator = ";"
+		return s.UnmarshalText([]byte(raw))
+	})
+	defer vtypes.Register(typ, nil)
+
+	tests := []struct {
+		name     string
+		input    func() any
+		raw      string
+		wantPath string
+		wantMsg  string
+	}{
+		{
+			name: "slice",
+			input: func() any {
+				s := vtypes.MakeSlice(new([]int))
+				return &s
+			},
+			raw:      "1,2,x",
+			wantPath: "[2]",
+			wantMsg:  `slice[2]: vtypes: hydrate (type: *int, raw: "x")`,
+		},
+		{
+			name: "map value",
+			input: func() any {
+				m := vtypes.MakeMap(new(map[string]int))
+				return &m
+			},
+			raw:      "a=1,b=x",
+			wantPath: `["b"]`,
+			wantMsg:  `map["b"]: vtypes: hydrate (type: *int, raw: "x")`,
+		},
+		{
+			name: "map key",
+			input: func() any {
+				m := vtypes.MakeMap(new(map[int]int))
+				return &m
+			},
+			raw:      "x=1",
+			wantPath: `["x"]`,
+			wantMsg:  `map["x"]: key: vtypes: hydrate (type: *int, raw: "x")`,
+		},
+		{
+			name: "nested",
+			input: func() any {
+				m := vtypes.MakeMap(new(map[string][]int))
+				return &m
+			},
+			raw:      "a=1;2,b=3;x",
+			wantPath: `["b"][1]`,
+			wantMsg:  `slice[1]: vtypes: hydrate (type: *int, raw: "x")`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := vtypes.Hydrate(tt.input(), tt.raw)
+			if err == nil {
+				t.Fatalf("Expected error, got nil")
+			}
+			if got := vtypes.ErrorPath(err); got != tt.wantPath {
+				t.Errorf("Expected path %q, got %q", tt.wantPath, got)
+			}
+			if !strings.Contains(err.Error(), tt.wantMsg) {
+				t.Errorf("Expected error to contain %q, got %q", tt.wantMsg, err.Error())
+			}
+		})
+	}
+}
+
+// Ensure any flag.Value is handled as a StringSetter.
+var _ vtypes.StringSetter = flag.Value(nil)
+
+// level is a flag.Value accepting only "low" or "high".
+type level string
+
+func (l *level) String() string { return string(*l) }
+
+func (l *level) Set(s string) error {
+	if s != "low" && s != "high" {
+		return errBadLevel
+	}
+	*l = level(s)
+	return nil
+}
+
+var errBadLevel = errors.New("bad level")
+
+func TestSurfaceHydrateFlagValue(t *testing.T) {
+	var l level
+	var fv flag.Value = &l
+
+	if err := vtypes.Hydrate(fv, "high"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if l != "high" {
+		t.Errorf("Expected high, got %q", l)
+	}
+
+	err := vtypes.Hydrate(fv, "mid")
+	if !errors.Is(err, errBadLevel) {
+		t.Errorf("Expected errBadLevel in chain, got %v", err)
+	}
+	var herr *vtypes.HydrateError
+	if !errors.As(err, &herr) {
+		t.Errorf("Expected *vtypes.HydrateError in chain, got %v", err)
+	}
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(fv, "level", "")
+	if err := vtypes.Hydrate(fs.Lookup("level").Value, "low"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if l != "low" {
+		t.Errorf("Expected low, got %q", l)
+	}
+}
+
+func TestConvertCompatiblePointerDepthMatrix(t *testing.T) {
+	tests := []struct {
+		name  string
+		input any
+		want  any
+	}{
+		{name: "slice value", input: []int{}, want: &vtypes.Slice{}},
+		{name: "slice single", input: new([]int), want: &vtypes.Slice{}},
+		{name: "slice double", input: ptr(new([]int)), want: &vtypes.Slice{}},
+		{name: "slice triple", input: ptr(ptr(new([]int))), want: &vtypes.Slice{}},
+		{name: "array single", input: new([2]int), want: &vtypes.Array{}},
+		{name: "array double", input: ptr(new([2]int)), want: &vtypes.Array{}},
+		{name: "array triple", input: ptr(ptr(new([2]int))), want: &vtypes.Array{}},
+		{name: "map single", input: new(map[string]int), want: &vtypes.Map{}},
+		{name: "map double", input: ptr(new(map[string]int)), want: &vtypes.Map{}},
+		{name: "map triple", input: ptr(ptr(new(map[string]int))), want: &vtypes.Map{}},
+		{name: "int single", input: new(int), want: new(int)},
+		{name: "int double", input: ptr(new(int)), want: ptr(new(int))},
+		{name: "func", input: func(string) error { return nil }, want: vtypes.OnSetFunc(nil)},
+		{name: "bool func", input: func(bool) error { return nil }, want: vtypes.OnSetBoolFunc(nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := vtypes.ConvertCompatible(tt.input)
+			if reflect.TypeOf(got) != reflect.TypeOf(tt.want) {
+				t.Errorf("ConvertCompatible() got type %T, want %T", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertCompatibleWithSliceOptions(t *testing.T) {
+	var got []int
+
+	result := vtypes.ConvertCompatibleWith(&got, vtypes.WithSeparator("|"))
+	sliceVal, ok := result.(*vtypes.Slice)
+	if !ok {
+		t.Fatalf("Expected *vtypes.Slice, got %T", result)
+	}
+	if sliceVal.Separator != "|" {
+		t.Errorf("Expected separator |, got %q", sliceVal.Separator)
+	}
+	if err := vtypes.Hydrate(sliceVal, "1|2|3"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected slice values %v, got %v", want, got)
+	}
+
+	defaults, ok := vtypes.ConvertCompatibleWith(&got).(*vtypes.Slice)
+	if !ok {
+		t.Fatalf("Expected *vtypes.Slice, got %T", result)
+	}
+	if want := vtypes.MakeSlice(&got); !reflect.DeepEqual(*defaults, want) {
+		t.Errorf("Expected default Slice %+v, got %+v", want, *defaults)
+	}
+}
+
+// pairFlags is a map type which handles its own hydration.
+type pairFlags map[string]string
+
+func (p *pairFlags) Set(s string) error {
+	k, v, ok := strings.Cut(s, ":")
+	if !ok {
+		return errors.New("missing colon")
+	}
+	if *p == nil {
+		*p = make(pairFlags)
+	}
+	(*p)[k] = v
+	return nil
+}
+
+func (p *pairFlags) String() string { return fmt.Sprint(map[string]string(*p)) }
+
+func TestConvertCompatibleMapSetter(t *testing.T) {
+	var got pairFlags
+
+	result := vtypes.ConvertCompatible(&got)
+	if _, ok := result.(*pairFlags); !ok {
+		t.Fatalf("Expected *pairFlags to be left as-is, got %T", result)
+	}
+	if err := vtypes.Hydrate(result, "a:b"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if want := (pairFlags{"a": "b"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestConvertCompatibleSliceExceptions(t *testing.T) {
+	var ip net.IP
+	result := vtypes.ConvertCompatible(&ip)
+	if _, ok := result.(*net.IP); !ok {
+		t.Fatalf("Expected *net.IP to be left as-is, got %T", result)
+	}
+	if err := vtypes.Hydrate(result, "192.168.0.1"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if want := net.ParseIP("192.168.0.1"); !ip.Equal(want) {
+		t.Errorf("Expected %v, got %v", want, ip)
+	}
+
+	var b []byte
+	result = vtypes.ConvertCompatible(&b)
+	if _, ok := result.(*[]byte); !ok {
+		t.Fatalf("Expected *[]byte to be left as-is, got %T", result)
+	}
+	if err := vtypes.Hydrate(result, "1,2"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if string(b) != "1,2" {
+		t.Errorf("Expected raw bytes %q, got %q", "1,2", b)
+	}
+}
+
+// hexID is an array type which handles its own hydration.
+type hexID [2]byte
+
+func (h *hexID) UnmarshalText(text []byte) error {
+	_, err := hex.Decode(h[:], text)
+	return err
+}
+
+func (h *hexID) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString(h[:])), nil
+}
+
+func TestConvertCompatibleArrayTextUnmarshaler(t *testing.T) {
+	var got hexID
+
+	result := vtypes.ConvertCompatible(&got)
+	if _, ok := result.(*hexID); !ok {
+		t.Fatalf("Expected *hexID to be left as-is, got %T", result)
+	}
+	if err := vtypes.Hydrate(result, "abcd"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if want := (hexID{0xab, 0xcd}); got != want {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+// tags implements only Set, so it is a Setter but not a StringSetter.
+type tags struct {
+	vals []string
+}
+
+func (t *tags) Set(s string) error {
+	t.vals = append(t.vals, s)
+	return nil
+}
+
+// named implements both Set and String, so it is handled as a StringSetter.
+type named struct {
+	setter string
+}
+
+func (n *named) Set(s string) error {
+	n.setter = "string setter"
+	return nil
+}
+
+func (n *named) String() string { return n.setter }
+
+func TestSurfaceHydrateSetter(t *testing.T) {
+	var tg tags
+	for _, raw := range []string{"a", "b"} {
+		if err := vtypes.Hydrate(&tg, raw); err != nil {
+			t.Fatalf("Hydrate error: %v", err)
+		}
+	}
+	if want := []string{"a", "b"}; !reflect.DeepEqual(tg.vals, want) {
+		t.Errorf("Expected %q, got %q", want, tg.vals)
+	}
+	if name := vtypes.ValueTypeName(&tg); name != "value" {
+		t.Errorf("Expected type name value, got %q", name)
+	}
+	if text := vtypes.DefaultValueText(&tg); text != "{[a b]}" {
+		t.Errorf("Expected default text {[a b]}, got %q", text)
+	}
+
+	var n named
+	if err := vtypes.Hydrate(&n, "x"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if text := vtypes.DefaultValueText(&n); text != "string setter" {
+		t.Errorf("Expected default text from String, got %q", text)
+	}
+}
+
+type ctxKey struct{}
+
+type lookup struct {
+	val    string
+	prefix string
+}
+
+func (l *lookup) SetContext(ctx context.Context, s string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	l.prefix, _ = ctx.Value(ctxKey{}).(string)
+	l.val = s
+	return nil
+}
+
+func TestSurfaceHydrateContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxKey{}, "pfx")
+
+	var l lookup
+	if err := vtypes.HydrateContext(ctx, &l, "x"); err != nil {
+		t.Fatalf("HydrateContext error: %v", err)
+	}
+	if l.val != "x" || l.prefix != "pfx" {
+		t.Errorf("Expected val x and prefix pfx, got %q and %q", l.val, l.prefix)
+	}
+
+	var pl *lookup // nil, exercised through a double pointer
+	if err := vtypes.Hydrate(&pl, "y"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if pl == nil || pl.val != "y" || pl.prefix != "" {
+		t.Errorf("Expected val y without prefix, got %+v", pl)
+	}
+	if name := vtypes.ValueTypeName(&l); name != "value" {
+		t.Errorf("Expected type name value, got %q", name)
+	}
+
+	cctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	n := 1
+	err := vtypes.HydrateContext(cctx, &n, "2")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if n != 1 {
+		t.Errorf("Expected value to be untouched, got %d", n)
+	}
+}
+
+// ctxRecorder records the context it is set with.
+type ctxRecorder struct {
+	ctx context.Context
+	val string
+}
+
+func (c *ctxRecorder) SetContext(ctx context.Context, s string) error {
+	c.ctx, c.val = ctx, s
+	return nil
+}
+
+func TestHydrateContextWrapped(t *testing.T) {
+	var elems []ctxRecorder
+	sv := vtypes.MakeSlice(&elems, vtypes.WithSplitEach(true))
+
+	var rec ctxRecorder
+	vv := vtypes.MakeValidated(&rec, func(any) error { return nil })
+
+	tests := []struct {
+		name string
+		val  any
+		raw  string
+		got  func() []ctxRecorder
+	}{
+		{name: "slice", val: &sv, raw: "a,b", got: func() []ctxRecorder { return elems }},
+		{name: "validated", val: &vv, raw: "a", got: func() []ctxRecorder { return []ctxRecorder{rec} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			if err := vtypes.HydrateContext(ctx, tt.val, tt.raw); err != nil {
+				t.Fatalf("HydrateContext error: %v", err)
+			}
+			cancel()
+
+			got := tt.got()
+			if len(got) == 0 {
+				t.Fatal("Expected hydrated elements, got none")
+			}
+			for i, r := range got {
+				if r.ctx == nil || !errors.Is(r.ctx.Err(), context.Canceled) {
+					t.Errorf("Expected element %d to be set with the cancelled context, got %v", i, r.ctx)
+				}
+			}
+
+			err := vtypes.HydrateContext(ctx, tt.val, tt.raw)
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("Expected context.Canceled, got %v", err)
+			}
+		})
+	}
+}
+
+func BenchmarkHydrate(b *testing.B) {
+	b.Run("int", func(b *testing.B) {
+		var n int
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := vtypes.Hydrate(&n, "42"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("string", func(b *testing.B) {
+		var s string
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := vtypes.Hydrate(&s, "abc"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("double pointer int", func(b *testing.B) {
+		var n *int
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := vtypes.Hydrate(&n, "42"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestSurfaceHydrateValue(t *testing.T) {
+	var cfg struct {
+		Port    int
+		Hosts   *[]string
+		Timeout time.Duration
+		secret  string
+	}
+	rv := reflect.ValueOf(&cfg).Elem()
+
+	if err := vtypes.HydrateValue(rv.Field(0), "8080"); err != nil {
+		t.Fatalf("HydrateValue error: %v", err)
+	}
+	if err := vtypes.HydrateValue(rv.Field(2), "5s"); err != nil {
+		t.Fatalf("HydrateValue error: %v", err)
+	}
+	if cfg.Port != 8080 || cfg.Timeout != 5*time.Second {
+		t.Errorf("Expected port 8080 and timeout 5s, got %d and %v", cfg.Port, cfg.Timeout)
+	}
+
+	s := vtypes.MakeSlice(rv.Field(1).Addr().Interface())
+	if err := vtypes.HydrateValue(reflect.ValueOf(&s).Elem(), "a,b"); err != nil {
+		t.Fatalf("HydrateValue error: %v", err)
+	}
+	if cfg.Hosts == nil || !reflect.DeepEqual(*cfg.Hosts, []string{"a", "b"}) {
+		t.Errorf("Expected hosts [a b], got %v", cfg.Hosts)
+	}
+
+	err := vtypes.HydrateValue(rv.Field(0), "x")
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) {
+		t.Errorf("Expected *strconv.NumError in chain, got %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		rv      reflect.Value
+		want    error
+		wantMsg string
+	}{
+		{name: "not addressable", rv: reflect.ValueOf(1), want: vtypes.ErrNotPointer, wantMsg: `hydrate (type: int, raw: "1")`},
+		{name: "unexported", rv: rv.Field(3), want: vtypes.ErrNotPointer, wantMsg: `hydrate (type: string, raw: "1")`},
+		{name: "invalid", rv: reflect.Value{}, want: vtypes.ErrNilValue, wantMsg: `hydrate (type: <nil>, raw: "1")`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := vtypes.HydrateValue(tt.rv, "1")
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v in chain, got %v", tt.want, err)
+			}
+			var herr *vtypes.HydrateError
+			if !errors.As(err, &herr) || herr.Raw != "1" {
+				t.Errorf("Expected *vtypes.HydrateError with raw value, got %v", err)
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantMsg) {
+				t.Errorf("Expected message containing %q, got %v", tt.wantMsg, err)
+			}
+		})
+	}
+}
+
+func TestSurfaceHydrateIntOverflow(t *testing.T) {
+	tests := []struct {
+		name string
+		val  any
+		raw  string
+		want string
+	}{
+		{name: "int8", val: new(int8), raw: "99999", want: "value 99999 overflows int8 (range -128..127)"},
+		{name: "int8 negative", val: new(int8), raw: "-129", want: "value -129 overflows int8 (range -128..127)"},
+		{name: "int16", val: new(int16), raw: "32768", want: "value 32768 overflows int16 (range -32768..32767)"},
+		{name: "int32", val: new(int32), raw: "2147483648", want: "value 2147483648 overflows int32 (range -2147483648..2147483647)"},
+		{name: "int64", val: new(int64), raw: "9223372036854775808", want: "value 9223372036854775808 overflows int64 (range -9223372036854775808..9223372036854775807)"},
+		{name: "uint8", val: new(uint8), raw: "256", want: "value 256 overflows uint8 (range 0..255)"},
+		{name: "uint16", val: new(uint16), raw: "65536", want: "value 65536 overflows uint16 (range 0..65535)"},
+		{name: "uint32", val: new(uint32), raw: "4294967296", want: "value 4294967296 overflows uint32 (range 0..4294967295)"},
+		{name: "uint64", val: new(uint64), raw: "18446744073709551616", want: "value 18446744073709551616 overflows uint64 (range 0..18446744073709551615)"},
+		{name: "int", val: new(int), raw: "9223372036854775808", want: "overflows int (range "},
+		{name: "uint", val: new(uint), raw: "18446744073709551616", want: "overflows uint (range 0.."},
+		{name: "int wrapper", val: func() any {
+			n := vtypes.MakeInt(new(int8), 16)
+			return &n
+		}(), raw: "ff", want: "value ff overflows int8 (range -128..127)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := vtypes.Hydrate(tt.val, tt.raw)
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected message containing %q, got %q", tt.want, err)
+			}
+			if !errors.Is(err, strconv.ErrRange) {
+				t.Errorf("Expected strconv.ErrRange in chain, got %v", err)
+			}
+			var numErr *strconv.NumError
+			if !errors.As(err, &numErr) {
+				t.Errorf("Expected *strconv.NumError in chain, got %v", err)
+			}
+		})
+	}
+
+	err := vtypes.Hydrate(new(int8), "x")
+	if err == nil || strings.Contains(err.Error(), "overflows") {
+		t.Errorf("Expected syntax error without overflow message, got %v", err)
+	}
+}
+
+func TestSurfaceHydrateUintNegative(t *testing.T) {
+	tests := []struct {
+		name string
+		val  any
+		raw  string
+		want string
+	}{
+		{name: "uint", val: new(uint), raw: "-1", want: "value -1 must be non-negative for uint"},
+		{name: "uint negative zero", val: new(uint), raw: "-0", want: "value -0 must be non-negative for uint"},
+		{name: "uint8", val: new(uint8), raw: "-1", want: "value -1 must be non-negative for uint8"},
+		{name: "uint64", val: new(uint64), raw: "-0", want: "value -0 must be non-negative for uint64"},
+		{name: "uint32 double", val: ptr(new(uint32)), raw: "-1", want: "value -1 must be non-negative for uint32"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := vtypes.Hydrate(tt.val, tt.raw)
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected message containing %q, got %q", tt.want, err)
+			}
+			if !errors.Is(err, strconv.ErrSyntax) {
+				t.Errorf("Expected strconv.ErrSyntax in chain, got %v", err)
+			}
+		})
+	}
+
+	err := vtypes.Hydrate(new(uint), "-x")
+	if err == nil || strings.Contains(err.Error(), "non-negative") {
+		t.Errorf("Expected syntax error without non-negative message, got %v", err)
+	}
+	err = vtypes.Hydrate(new(int), "-1")
+	if err != nil {
+		t.Errorf("Expected no error for signed value, got %v", err)
+	}
+}
+
+func TestSurfaceHydrateSQLNull(t *testing.T) {
+	var ns sql.NullString
+	if err := vtypes.Hydrate(&ns, ""); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if want := (sql.NullString{String: "", Valid: true}); ns != want {
+		t.Errorf("Expected %+v, got %+v", want, ns)
+	}
+
+	var ni sql.NullInt64
+	if err := vtypes.Hydrate(&ni, "42"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if want := (sql.NullInt64{Int64: 42, Valid: true}); ni != want {
+		t.Errorf("Expected %+v, got %+v", want, ni)
+	}
+
+	var nb *sql.NullBool // nil, exercised through a double pointer
+	if err := vtypes.Hydrate(&nb, "true"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if want := (sql.NullBool{Bool: true, Valid: true}); nb == nil || *nb != want {
+		t.Errorf("Expected %+v, got %+v", want, nb)
+	}
+
+	var nf sql.NullFloat64
+	err := vtypes.Hydrate(&nf, "x")
+	var herr *vtypes.HydrateError
+	if !errors.As(err, &herr) || herr.Raw != "x" {
+		t.Errorf("Expected *vtypes.HydrateError for Scan error, got %v", err)
+	}
+}
+
+func TestSurfaceDefaultValueTextSQLNull(t *testing.T) {
+	tests := []struct {
+		name string
+		val  any
+		want string
+	}{
+		{name: "valid string", val: &sql.NullString{String: "a", Valid: true}, want: "a"},
+		{name: "invalid string", val: &sql.NullString{String: "a"}, want: ""},
+		{name: "valid int", val: &sql.NullInt64{Int64: 7, Valid: true}, want: "7"},
+		{name: "invalid int", val: &sql.NullInt64{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if text := vtypes.DefaultValueText(tt.val); text != tt.want {
+				t.Errorf("Expected default text %q, got %q", tt.want, text)
+			}
+		})
+	}
+}
+
+func TestSurfaceHydrateDebug(t *testing.T) {
+	var n int
+	var pn *int
+	var ppn **int
+	var s []string
+	sv := vtypes.MakeSlice(&s)
+
+	tests := []struct {
+		name       string
+		val        any
+		raw        string
+		wantLevels int
+		wantErr    bool
+	}{
+		{name: "single", val: &n, raw: "1", wantLevels: 1},
+		{name: "double", val: &pn, raw: "2", wantLevels: 2},
+		{name: "triple", val: &ppn, raw: "3", wantLevels: 3},
+		{name: "wrapper", val: &sv, raw: "a,b", wantLevels: 1},
+		{name: "invalid", val: &n, raw: "x", wantLevels: 1, wantErr: true},
+		{name: "not pointer", val: n, raw: "1", wantLevels: 0, wantErr: true},
+		{name: "nil pointer", val: (*int)(nil), raw: "1", wantLevels: 0, wantErr: true},
+		{name: "nil", val: nil, raw: "1", wantLevels: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			levels, err := vtypes.HydrateDebug(tt.val, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HydrateDebug() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if levels != tt.wantLevels {
+				t.Errorf("Expected %d levels, got %d", tt.wantLevels, levels)
+			}
+		})
+	}
+
+	if ppn == nil || *ppn == nil || **ppn != 3 {
+		t.Errorf("Expected triple pointer to be hydrated to 3, got %v", ppn)
+	}
+}
+
+func TestSurfaceHydrateAll(t *testing.T) {
+	var (
+		port    int
+		host    string
+		timeout time.Duration
+		debug   bool
+	)
+
+	err := vtypes.HydrateAll(
+		vtypes.Field{Name: "port", Val: &port, Raw: "http"},
+		vtypes.Field{Name: "host", Val: &host, Raw: "localhost"},
+		vtypes.Field{Name: "timeout", Val: &timeout, Raw: "soon"},
+		vtypes.Field{Name: "debug", Val: &debug, Raw: "true"},
+		vtypes.Field{Name: "bad", Val: 1, Raw: "1"},
+	)
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if host != "localhost" || !debug {
+		t.Errorf("Expected valid fields to be hydrated, got host %q and debug %t", host, debug)
+	}
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("Expected joined error, got %T", err)
+	}
+	var names []string
+	for _, e := range joined.Unwrap() {
+		var ferr *vtypes.FieldError
+		if !errors.As(e, &ferr) {
+			t.Fatalf("Expected *vtypes.FieldError, got %v", e)
+		}
+		names = append(names, ferr.Name)
+	}
+	if want := []string{"port", "timeout", "bad"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Expected failing fields %q, got %q", want, names)
+	}
+
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Expected strconv.ErrSyntax in chain, got %v", err)
+	}
+	if !errors.Is(err, vtypes.ErrNotPointer) {
+		t.Errorf("Expected ErrNotPointer in chain, got %v", err)
+	}
+	for _, name := range []string{"port: ", "timeout: ", "bad: "} {
+		if !strings.Contains(err.Error(), name) {
+			t.Errorf("Expected message to name %q, got %q", name, err)
+		}
+	}
+
+	if err := vtypes.HydrateAll(vtypes.Field{Name: "port", Val: &port, Raw: "80"}); err != nil {
+		t.Errorf("Expected nil error, got %v", err)
+	}
+	if err := vtypes.HydrateAll(); err != nil {
+		t.Errorf("Expected nil error for no fields, got %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package vtypes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Weekday is an implementation of TextMarshalUnmarshaler that wraps a
+// time.Weekday value which is parsed from its full or three letter name
+// (case-insensitive) or from its number (0-6, with 0 being Sunday).
+type Weekday struct {
+	ptr *time.Weekday
+}
+
+// MakeWeekday returns an instance of Weekday.
+func MakeWeekday(ptr *time.Weekday) Weekday {
+	return Weekday{
+		ptr: ptr,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (w *Weekday) UnmarshalText(text []byte) error {
+	s := string(text)
+	if n, err := strconv.Atoi(s); err == nil {
+		if n < 0 || n > 6 {
+			return fmt.Errorf("%w: weekday %d not in 0..6", ErrOutOfRange, n)
+		}
+		*w.ptr = time.Weekday(n)
+		return nil
+	}
+
+	names := make([]string, 0, 7)
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		name := d.String()
+		if strings.EqualFold(s, name) || strings.EqualFold(s, name[:3]) {
+			*w.ptr = d
+			return nil
+		}
+		names = append(names, name)
+	}
+	return fmt.Errorf("%w: weekday %q (valid: %s)", ErrValueUnsupported, s, strings.Join(names, "|"))
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (w *Weekday) MarshalText() ([]byte, error) {
+	if w.ptr == nil {
+		return nil, nil
+	}
+	return []byte(w.ptr.String()), nil
+}
+
+// ValueTypeName returns the name of the wrapped type.
+func (w *Weekday) ValueTypeName() string {
+	return "weekday"
+}
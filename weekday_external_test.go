@@ -0,0 +1,55 @@
+package vtypes_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/daved/vtypes"
+)
+
+func TestWeekday(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    time.Weekday
+		wantErr bool
+	}{
+		{name: "full", raw: "Monday", want: time.Monday},
+		{name: "abbreviated", raw: "Mon", want: time.Monday},
+		{name: "lower", raw: "saturday", want: time.Saturday},
+		{name: "abbreviated upper", raw: "THU", want: time.Thursday},
+		{name: "sunday number", raw: "0", want: time.Sunday},
+		{name: "number", raw: "6", want: time.Saturday},
+		{name: "seven", raw: "7", want: time.Wednesday, wantErr: true},
+		{name: "negative", raw: "-1", want: time.Wednesday, wantErr: true},
+		{name: "unknown", raw: "Funday", want: time.Wednesday, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := time.Wednesday
+			wv := vtypes.MakeWeekday(&got)
+
+			err := vtypes.Hydrate(&wv, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Hydrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	got := time.Wednesday
+	wv := vtypes.MakeWeekday(&got)
+	if err := wv.UnmarshalText([]byte("Funday")); err == nil || !strings.Contains(err.Error(), "Sunday|Monday") {
+		t.Errorf("Expected error to list names, got %v", err)
+	}
+	if text := vtypes.DefaultValueText(&wv); text != "Wednesday" {
+		t.Errorf("Expected default text Wednesday, got %q", text)
+	}
+	if name := vtypes.ValueTypeName(&wv); name != "weekday" {
+		t.Errorf("Expected type name weekday, got %q", name)
+	}
+}
@@ -0,0 +1,46 @@
+package vtypes
+
+import "context"
+
+// WithDefault is an implementation of TextMarshalUnmarshaler that wraps any
+// value supported by [Hydrate], overriding the text returned by
+// [DefaultValueText]. This is useful for types that cannot implement
+// [DefaultValueTexter] directly.
+type WithDefault struct {
+	ptr         any
+	defaultText string
+}
+
+// MakeWithDefault returns an instance of WithDefault.
+func MakeWithDefault(ptr any, defaultText string) WithDefault {
+	return WithDefault{
+		ptr:         ptr,
+		defaultText: defaultText,
+	}
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (w *WithDefault) UnmarshalText(text []byte) error {
+	return w.unmarshalTextContext(context.Background(), text)
+}
+
+// unmarshalTextContext is like UnmarshalText, but hydrates the wrapped value using ctx.
+func (w *WithDefault) unmarshalTextContext(ctx context.Context, text []byte) error {
+	return hydrate(ctx, w.ptr, string(text))
+}
+
+// MarshalText implements [encoding.TextMarshaler]. The wrapped value is
+// rendered as it would be without the override.
+func (w *WithDefault) MarshalText() ([]byte, error) {
+	return []byte(DefaultValueText(w.ptr)), nil
+}
+
+// ValueTypeName returns the type name of the wrapped value.
+func (w *WithDefault) ValueTypeName() string {
+	return ValueTypeName(w.ptr)
+}
+
+// DefaultValueText returns the override text.
+func (w *WithDefault) DefaultValueText() string {
+	return w.defaultText
+}
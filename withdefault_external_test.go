@@ -0,0 +1,34 @@
+package vtypes_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daved/vtypes"
+)
+
+func TestWithDefault(t *testing.T) {
+	var got time.Duration
+	wv := vtypes.MakeWithDefault(&got, "no timeout")
+
+	if text := vtypes.DefaultValueText(&wv); text != "no timeout" {
+		t.Errorf("Expected default text override, got %q", text)
+	}
+	if name := vtypes.ValueTypeName(&wv); name != "Duration" {
+		t.Errorf("Expected type name Duration, got %q", name)
+	}
+
+	if err := vtypes.Hydrate(&wv, "90s"); err != nil {
+		t.Fatalf("Hydrate error: %v", err)
+	}
+	if got != 90*time.Second {
+		t.Errorf("Expected 1m30s, got %v", got)
+	}
+	if text, _ := wv.MarshalText(); string(text) != "1m30s" {
+		t.Errorf("Expected text 1m30s, got %q", text)
+	}
+
+	if err := vtypes.Hydrate(&wv, "soon"); err == nil {
+		t.Errorf("Expected error for invalid duration, got nil")
+	}
+}